@@ -0,0 +1,175 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// AddrPort is a nullable netip.AddrPort, an IP address and port number.
+// It will marshal to null if null, and to the address and port's text form otherwise.
+type AddrPort struct {
+	AddrPort netip.AddrPort
+	Valid    bool // Valid is true if AddrPort is not NULL
+}
+
+// NewAddrPort creates a new AddrPort.
+func NewAddrPort(ap netip.AddrPort, valid bool) AddrPort {
+	return AddrPort{
+		AddrPort: ap,
+		Valid:    valid,
+	}
+}
+
+// AddrPortFrom creates a new AddrPort that will always be valid.
+func AddrPortFrom(ap netip.AddrPort) AddrPort {
+	return NewAddrPort(ap, true)
+}
+
+// AddrPortFromPtr creates a new AddrPort that will be null if ap is nil.
+func AddrPortFromPtr(ap *netip.AddrPort) AddrPort {
+	if ap == nil {
+		return NewAddrPort(netip.AddrPort{}, false)
+	}
+	return NewAddrPort(*ap, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero netip.AddrPort.
+func (ap AddrPort) ValueOrZero() netip.AddrPort {
+	if !ap.Valid {
+		return netip.AddrPort{}
+	}
+	return ap.AddrPort
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (ap AddrPort) ValueOr(v netip.AddrPort) netip.AddrPort {
+	if !ap.Valid {
+		return v
+	}
+	return ap.AddrPort
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts string and []byte values, such as "192.0.2.1:80" or "[2001:db8::1]:443".
+func (ap *AddrPort) Scan(src any) error {
+	if src == nil {
+		ap.AddrPort, ap.Valid = netip.AddrPort{}, false
+		return nil
+	}
+	str, err := internal.ScanString(src)
+	if err == nil {
+		ap.AddrPort, err = netip.ParseAddrPort(str)
+	}
+	if err != nil {
+		ap.AddrPort, ap.Valid = netip.AddrPort{}, false
+		return fmt.Errorf("null: couldn't scan AddrPort: %w", err)
+	}
+	ap.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the address and port's text form.
+func (ap AddrPort) Value() (driver.Value, error) {
+	if !ap.Valid {
+		return nil, nil
+	}
+	return ap.AddrPort.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (ap *AddrPort) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		ap.AddrPort, ap.Valid = netip.AddrPort{}, false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return ap.UnmarshalText([]byte(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null AddrPort if the input is blank or "null".
+func (ap *AddrPort) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		ap.AddrPort, ap.Valid = netip.AddrPort{}, false
+		return nil
+	}
+	if err := ap.AddrPort.UnmarshalText(text); err != nil {
+		ap.Valid = false
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	ap.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this AddrPort is null, otherwise netip.AddrPort's text form.
+func (ap AddrPort) MarshalJSON() ([]byte, error) {
+	return ap.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this AddrPort to dst, as MarshalJSON does.
+// It will append null if this AddrPort is null.
+func (ap AddrPort) AppendJSON(dst []byte) ([]byte, error) {
+	if !ap.Valid {
+		return append(dst, "null"...), nil
+	}
+	var buf [64]byte
+	return internal.AppendJSONString(dst, ap.AddrPort.AppendTo(buf[:0])), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this AddrPort is null, otherwise netip.AddrPort's text form.
+func (ap AddrPort) MarshalText() ([]byte, error) {
+	return ap.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this AddrPort is null.
+func (ap AddrPort) AppendText(dst []byte) ([]byte, error) {
+	if !ap.Valid {
+		return dst, nil
+	}
+	return ap.AddrPort.AppendTo(dst), nil
+}
+
+// SetValid changes this AddrPort's value and also sets it to be non-null.
+func (ap *AddrPort) SetValid(v netip.AddrPort) {
+	ap.AddrPort = v
+	ap.Valid = true
+}
+
+// Ptr returns a pointer to this AddrPort's value, or a nil pointer if this AddrPort is null.
+func (ap AddrPort) Ptr() *netip.AddrPort {
+	if !ap.Valid {
+		return nil
+	}
+	return &ap.AddrPort
+}
+
+// IsZero returns true for null AddrPorts.
+// A non-null AddrPort with a zero value will not be considered zero.
+func (ap AddrPort) IsZero() bool {
+	return !ap.Valid
+}
+
+// Equal returns true if both AddrPorts have the same value or are both null.
+func (ap AddrPort) Equal(other AddrPort) bool {
+	return ap.Valid == other.Valid && (!ap.Valid || ap.AddrPort == other.AddrPort)
+}
+
+// Compare returns an integer comparing two AddrPorts, following the conventions of cmp.Compare.
+// Values are compared with netip.AddrPort's Compare method.
+// Null is less than any non-null value.
+func (ap AddrPort) Compare(other AddrPort) int {
+	return internal.CompareFunc(ap.AddrPort, ap.Valid, other.AddrPort, other.Valid, netip.AddrPort.Compare)
+}
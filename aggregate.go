@@ -0,0 +1,196 @@
+package null
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// nullable is satisfied by the types in this package and the zero package.
+// For types in this package, IsZero reports whether the value is null.
+// For zero types, it also reports zero values, which that package considers equivalent to null.
+type nullable[T any] interface {
+	IsZero() bool
+	ValueOrZero() T
+}
+
+// settable is satisfied by pointers to the types in this package.
+type settable[N, T any] interface {
+	*N
+	SetValid(T)
+}
+
+type number interface {
+	int64 | int32 | int16 | int8 | uint64 | uint32 | uint16 | uint8 | float64 | float32
+}
+
+// The aggregate functions follow the rules of SQL aggregates:
+// null values are skipped, and the result of an empty or all-null input is null
+// (except for Count, which is 0).
+// Each has a counterpart that takes an iter.Seq, such as SumSeq.
+
+// Sum returns the sum of the non-null values, like SQL's SUM.
+// It returns null if there are no non-null values.
+// Integers are summed exactly, as SQL does by widening the result,
+// so intermediate totals never wrap around. If the final total doesn't fit into the input type,
+// Sum returns ErrOverflow. Floats are summed as usual and never return an error.
+func Sum[N nullable[T], T number, PN settable[N, T]](values []N) (N, error) {
+	var sum accumulator[T]
+	for _, v := range values {
+		if v.IsZero() {
+			continue
+		}
+		sum.add(v.ValueOrZero())
+	}
+	return sumResult[N, T, PN](sum)
+}
+
+// Avg returns the mean of the non-null values, like SQL's AVG.
+// It returns null if there are no non-null values.
+// The average is calculated using float64.
+func Avg[N nullable[T], T number](values []N) Float {
+	var sum float64
+	var count int
+	for _, v := range values {
+		if v.IsZero() {
+			continue
+		}
+		sum += float64(v.ValueOrZero())
+		count++
+	}
+	return avgResult(sum, count)
+}
+
+// Min returns the smallest non-null value, like SQL's MIN.
+// It returns null if there are no non-null values.
+// For types that aren't ordered, such as time.Time, use MinFunc.
+func Min[N nullable[T], T cmp.Ordered, PN settable[N, T]](values []N) N {
+	return MinFunc[N, T, PN](values, cmp.Compare[T])
+}
+
+// MinFunc returns the smallest non-null value, using cmp to compare values.
+// It returns null if there are no non-null values.
+// For example, MinFunc(times, time.Time.Compare) finds the earliest Time.
+func MinFunc[N nullable[T], T any, PN settable[N, T]](values []N, cmp func(a, b T) int) N {
+	var min T
+	var found bool
+	for _, v := range values {
+		if v.IsZero() {
+			continue
+		}
+		if x := v.ValueOrZero(); !found || cmp(x, min) < 0 {
+			min = x
+			found = true
+		}
+	}
+	return aggregateResult[N, T, PN](min, found)
+}
+
+// Max returns the largest non-null value, like SQL's MAX.
+// It returns null if there are no non-null values.
+// For types that aren't ordered, such as time.Time, use MaxFunc.
+func Max[N nullable[T], T cmp.Ordered, PN settable[N, T]](values []N) N {
+	return MaxFunc[N, T, PN](values, cmp.Compare[T])
+}
+
+// MaxFunc returns the largest non-null value, using cmp to compare values.
+// It returns null if there are no non-null values.
+// For example, MaxFunc(times, time.Time.Compare) finds the latest Time.
+func MaxFunc[N nullable[T], T any, PN settable[N, T]](values []N, cmp func(a, b T) int) N {
+	var max T
+	var found bool
+	for _, v := range values {
+		if v.IsZero() {
+			continue
+		}
+		if x := v.ValueOrZero(); !found || cmp(x, max) > 0 {
+			max = x
+			found = true
+		}
+	}
+	return aggregateResult[N, T, PN](max, found)
+}
+
+// Count returns the number of non-null values, like SQL's COUNT(column).
+func Count[N nullable[T], T any](values []N) int {
+	var count int
+	for _, v := range values {
+		if !v.IsZero() {
+			count++
+		}
+	}
+	return count
+}
+
+func aggregateResult[N any, T any, PN settable[N, T]](v T, valid bool) N {
+	var result N
+	if valid {
+		PN(&result).SetValid(v)
+	}
+	return result
+}
+
+func sumResult[N any, T number, PN settable[N, T]](sum accumulator[T]) (N, error) {
+	v, err := sum.value()
+	if err != nil {
+		var null N
+		return null, err
+	}
+	return aggregateResult[N, T, PN](v, sum.found), nil
+}
+
+// accumulator sums numbers. Integers are added to a 128-bit two's complement total,
+// which can't overflow when adding fewer than 2^63 values.
+type accumulator[T number] struct {
+	hi, lo uint64
+	float  T
+	found  bool
+}
+
+func (a *accumulator[T]) add(x T) {
+	a.found = true
+	if isFloat[T]() {
+		a.float += x
+		return
+	}
+	lo, carry := bits.Add64(a.lo, uint64(x), 0)
+	a.lo, a.hi = lo, a.hi+carry
+	if x < 0 {
+		// sign extension: add all ones to the high word
+		a.hi--
+	}
+}
+
+func (a accumulator[T]) value() (T, error) {
+	if isFloat[T]() {
+		return a.float, nil
+	}
+	if int64(a.hi) == int64(a.lo)>>63 {
+		// fits into an int64
+		n := int64(a.lo)
+		if v := T(n); int64(v) == n && (v < 0) == (n < 0) {
+			return v, nil
+		}
+	}
+	if a.hi == 0 {
+		// fits into a uint64
+		if v := T(a.lo); uint64(v) == a.lo && v >= 0 {
+			return v, nil
+		}
+	}
+	return 0, ErrOverflow
+}
+
+func isFloat[T number]() bool {
+	switch any(T(0)).(type) {
+	case float64, float32:
+		return true
+	}
+	return false
+}
+
+func avgResult(sum float64, count int) Float {
+	if count == 0 {
+		return Float{}
+	}
+	return FloatFrom(sum / float64(count))
+}
@@ -0,0 +1,87 @@
+//go:build go1.23
+
+package null
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SumSeq is like Sum, but takes an iterator.
+func SumSeq[N nullable[T], T number, PN settable[N, T]](values iter.Seq[N]) (N, error) {
+	var sum accumulator[T]
+	for v := range values {
+		if v.IsZero() {
+			continue
+		}
+		sum.add(v.ValueOrZero())
+	}
+	return sumResult[N, T, PN](sum)
+}
+
+// AvgSeq is like Avg, but takes an iterator.
+func AvgSeq[N nullable[T], T number](values iter.Seq[N]) Float {
+	var sum float64
+	var count int
+	for v := range values {
+		if v.IsZero() {
+			continue
+		}
+		sum += float64(v.ValueOrZero())
+		count++
+	}
+	return avgResult(sum, count)
+}
+
+// MinSeq is like Min, but takes an iterator.
+func MinSeq[N nullable[T], T cmp.Ordered, PN settable[N, T]](values iter.Seq[N]) N {
+	return MinFuncSeq[N, T, PN](values, cmp.Compare[T])
+}
+
+// MinFuncSeq is like MinFunc, but takes an iterator.
+func MinFuncSeq[N nullable[T], T any, PN settable[N, T]](values iter.Seq[N], cmp func(a, b T) int) N {
+	var min T
+	var found bool
+	for v := range values {
+		if v.IsZero() {
+			continue
+		}
+		if x := v.ValueOrZero(); !found || cmp(x, min) < 0 {
+			min = x
+			found = true
+		}
+	}
+	return aggregateResult[N, T, PN](min, found)
+}
+
+// MaxSeq is like Max, but takes an iterator.
+func MaxSeq[N nullable[T], T cmp.Ordered, PN settable[N, T]](values iter.Seq[N]) N {
+	return MaxFuncSeq[N, T, PN](values, cmp.Compare[T])
+}
+
+// MaxFuncSeq is like MaxFunc, but takes an iterator.
+func MaxFuncSeq[N nullable[T], T any, PN settable[N, T]](values iter.Seq[N], cmp func(a, b T) int) N {
+	var max T
+	var found bool
+	for v := range values {
+		if v.IsZero() {
+			continue
+		}
+		if x := v.ValueOrZero(); !found || cmp(x, max) > 0 {
+			max = x
+			found = true
+		}
+	}
+	return aggregateResult[N, T, PN](max, found)
+}
+
+// CountSeq is like Count, but takes an iterator.
+func CountSeq[N nullable[T], T any](values iter.Seq[N]) int {
+	var count int
+	for v := range values {
+		if !v.IsZero() {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,38 @@
+//go:build go1.23
+
+package null
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAggregateSeq(t *testing.T) {
+	ints := []Int{IntFrom(1), {}, IntFrom(2), IntFrom(6)}
+	seq := slices.Values(ints)
+	sum, err := SumSeq(seq)
+	maybePanic(err)
+	assertArith(t, sum, IntFrom(9), "SumSeq")
+	assertArith(t, AvgSeq(seq), FloatFrom(3), "AvgSeq")
+	assertArith(t, MinSeq(seq), IntFrom(1), "MinSeq")
+	assertArith(t, MaxSeq(seq), IntFrom(6), "MaxSeq")
+	if n := CountSeq(seq); n != 3 {
+		t.Errorf("bad CountSeq: %d", n)
+	}
+
+	empty := slices.Values([]Int(nil))
+	sum, err = SumSeq(empty)
+	maybePanic(err)
+	assertArith(t, sum, Int{}, "SumSeq(empty)")
+	if _, err := SumSeq(slices.Values([]Int{IntFrom(math.MaxInt64), IntFrom(1)})); err != ErrOverflow {
+		t.Errorf("SumSeq overflow: error %v ≠ %v", err, ErrOverflow)
+	}
+	assertArith(t, AvgSeq(empty), Float{}, "AvgSeq(empty)")
+
+	early := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := slices.Values([]Time{{}, TimeFrom(early.Add(time.Hour)), TimeFrom(early)})
+	assertArith(t, MinFuncSeq(times, time.Time.Compare), TimeFrom(early), "MinFuncSeq")
+	assertArith(t, MaxFuncSeq(times, time.Time.Compare), TimeFrom(early.Add(time.Hour)), "MaxFuncSeq")
+}
@@ -0,0 +1,90 @@
+package null
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/guregu/null/v6/zero"
+)
+
+func TestSum(t *testing.T) {
+	ints := []Int{IntFrom(1), {}, IntFrom(2), IntFrom(0)}
+	assertSum(t, ints, IntFrom(3), nil, "Sum(ints)")
+	assertSum(t, []Int{}, Int{}, nil, "Sum(empty)")
+	assertSum(t, []Int{{}, {}}, Int{}, nil, "Sum(nulls)")
+	assertSum(t, []Int{IntFrom(0)}, IntFrom(0), nil, "Sum(0)")
+
+	floats := []Float{FloatFrom(1.5), {}, FloatFrom(2.25)}
+	assertSum(t, floats, FloatFrom(3.75), nil, "Sum(floats)")
+
+	bytes := []Byte{ByteFrom(1), ByteFrom(2)}
+	assertSum(t, bytes, ByteFrom(3), nil, "Sum(bytes)")
+
+	// intermediate totals don't wrap around
+	assertSum(t, []Int{IntFrom(math.MaxInt64), IntFrom(1), IntFrom(-2)}, IntFrom(math.MaxInt64-1), nil, "Sum(MaxInt64, 1, -2)")
+	assertSum(t, []Int8{Int8From(100), Int8From(100), Int8From(-100)}, Int8From(100), nil, "Sum(int8s)")
+	assertSum(t, []Uint{UintFrom(math.MaxUint64), UintFrom(1)}, Uint{}, ErrOverflow, "Sum(MaxUint64, 1)")
+	assertSum(t, []Int{IntFrom(math.MinInt64), IntFrom(-1)}, Int{}, ErrOverflow, "Sum(MinInt64, -1)")
+	assertSum(t, []Int8{Int8From(100), Int8From(100)}, Int8{}, ErrOverflow, "Sum(int8 overflow)")
+	assertSum(t, []zero.Int{zero.IntFrom(2), {}, zero.IntFrom(3)}, zero.IntFrom(5), nil, "Sum(zero ints)")
+}
+
+func assertSum[N interface {
+	nullable[T]
+	Equal(N) bool
+}, T number, PN settable[N, T]](t *testing.T, values []N, want N, wantErr error, from string) {
+	t.Helper()
+	got, err := Sum[N, T, PN](values)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("%s: error %v ≠ %v", from, err, wantErr)
+	}
+	assertArith(t, got, want, from)
+}
+
+func TestAvg(t *testing.T) {
+	ints := []Int{IntFrom(1), {}, IntFrom(2), IntFrom(6)}
+	assertArith(t, Avg(ints), FloatFrom(3), "Avg(ints)")
+	assertArith(t, Avg([]Int{}), Float{}, "Avg(empty)")
+	assertArith(t, Avg([]Float{{}, {}}), Float{}, "Avg(nulls)")
+
+	// no overflow for narrow types
+	bytes := []Byte{ByteFrom(200), ByteFrom(250)}
+	assertArith(t, Avg(bytes), FloatFrom(225), "Avg(bytes)")
+
+	// zero types consider zero to be null
+	zeros := []zero.Int{zero.IntFrom(2), zero.NewInt(0, true), zero.IntFrom(4)}
+	assertArith(t, Avg(zeros), FloatFrom(3), "Avg(zero ints)")
+}
+
+func TestMinMax(t *testing.T) {
+	ints := []Int{{}, IntFrom(5), IntFrom(-3), {}, IntFrom(10)}
+	assertArith(t, Min(ints), IntFrom(-3), "Min(ints)")
+	assertArith(t, Max(ints), IntFrom(10), "Max(ints)")
+	assertArith(t, Min([]Int{{}}), Int{}, "Min(nulls)")
+	assertArith(t, Max([]Int{}), Int{}, "Max(empty)")
+
+	strs := []String{StringFrom("b"), {}, StringFrom("a")}
+	assertArith(t, Min(strs), StringFrom("a"), "Min(strings)")
+	assertArith(t, Max(strs), StringFrom("b"), "Max(strings)")
+
+	early := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := []Time{TimeFrom(late), {}, TimeFrom(early)}
+	assertArith(t, MinFunc(times, time.Time.Compare), TimeFrom(early), "MinFunc(times)")
+	assertArith(t, MaxFunc(times, time.Time.Compare), TimeFrom(late), "MaxFunc(times)")
+	assertArith(t, MaxFunc([]Time{{}}, time.Time.Compare), Time{}, "MaxFunc(nulls)")
+}
+
+func TestCount(t *testing.T) {
+	if n := Count([]Int{IntFrom(0), {}, IntFrom(2)}); n != 2 {
+		t.Errorf("bad Count(ints): %d", n)
+	}
+	if n := Count([]Time{{}, TimeFrom(time.Time{})}); n != 1 {
+		t.Errorf("bad Count(times): %d", n)
+	}
+	if n := Count([]Float{}); n != 0 {
+		t.Errorf("bad Count(empty): %d", n)
+	}
+}
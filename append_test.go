@@ -0,0 +1,153 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math"
+	"math/big"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+// textAppender is encoding.TextAppender, which needs Go 1.24.
+type textAppender interface {
+	AppendText(b []byte) ([]byte, error)
+}
+
+type jsonAppender interface {
+	AppendJSON(dst []byte) ([]byte, error)
+}
+
+func TestAppendInterfaces(t *testing.T) {
+	for _, v := range xmlTypes {
+		if _, ok := v.(jsonAppender); !ok {
+			t.Errorf("%T doesn't implement AppendJSON", v)
+		}
+		if _, ok := v.(encoding.TextMarshaler); !ok {
+			continue
+		}
+		if _, ok := v.(textAppender); !ok {
+			t.Errorf("%T doesn't implement encoding.TextAppender", v)
+		}
+	}
+}
+
+func TestAppendMatchesMarshal(t *testing.T) {
+	values := []any{
+		StringFrom("<a&b>"), IntFrom(-12), Int32From(math.MaxInt32), Int16From(-3), Int8From(math.MinInt8), ByteFrom('a'),
+		UintFrom(math.MaxUint64), Uint32From(7), Uint16From(8), Uint8From(9),
+		FloatFrom(1.5), FloatFrom(1e21), Float32From(0.1), DecimalFrom(big.NewInt(-1250), 2),
+		BigIntFrom(new(big.Int).Lsh(big.NewInt(1), 70)), BoolFrom(true), BoolFrom(false),
+		TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 5, time.FixedZone("", -30*60))),
+		DateFrom(2000, time.January, 2), TimeOfDayFrom(1, 2, 3, 4), DurationFrom(90 * time.Second),
+		JSONFrom([]byte(`{"a":1}`)), BytesFrom([]byte("hi")), UUIDFrom([16]byte{15: 1}),
+		AddrFrom(netip.MustParseAddr("fe80::1%eth0")), PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")),
+		AddrPortFrom(netip.MustParseAddrPort("[::1]:80")),
+		ValueFrom([]int{1}), JSONValueFrom(map[string]int{"a": 1}), OptionalFrom("x"),
+	}
+	values = append(values, xmlTypes...)
+
+	prefix := []byte("prefix:")
+	for _, v := range values {
+		want, err := v.(json.Marshaler).MarshalJSON()
+		maybePanic(err)
+		got, err := v.(jsonAppender).AppendJSON(bytes.Clone(prefix))
+		maybePanic(err)
+		if !bytes.Equal(got, append(bytes.Clone(prefix), want...)) {
+			t.Errorf("%T %v: AppendJSON: %s ≠ %s", v, v, got, want)
+		}
+		if !json.Valid(want) {
+			t.Errorf("%T %v: invalid JSON: %s", v, v, want)
+		}
+
+		tm, ok := v.(encoding.TextMarshaler)
+		if !ok {
+			continue
+		}
+		want, err = tm.MarshalText()
+		maybePanic(err)
+		got, err = v.(textAppender).AppendText(bytes.Clone(prefix))
+		maybePanic(err)
+		if !bytes.Equal(got, append(bytes.Clone(prefix), want...)) {
+			t.Errorf("%T %v: AppendText: %s ≠ %s", v, v, got, want)
+		}
+	}
+}
+
+func TestAppendJSONString(t *testing.T) {
+	for _, s := range []string{
+		"", "plain", `"quoted" \back\slash`, "<script>&amp;</script>", "tab\tnew\nline\r\x00\x1f\x7f",
+		"\b\f", "é ü 日本 🙂", "  ", "bad \xff utf-8 \xe2\x82", "\xed\xa0\x80", "line\u2028para\u2029",
+	} {
+		want, err := json.Marshal(s)
+		maybePanic(err)
+		got, err := StringFrom(s).MarshalJSON()
+		maybePanic(err)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%q: %s ≠ %s", s, got, want)
+		}
+	}
+}
+
+func TestAppendDuration(t *testing.T) {
+	for _, d := range []time.Duration{
+		0, 1, -1, 999, time.Microsecond, 1500 * time.Microsecond, time.Millisecond + 1, time.Second,
+		-90 * time.Second, 25*time.Hour + 100*time.Millisecond, math.MaxInt64, math.MinInt64,
+	} {
+		got, err := DurationFrom(d).MarshalText()
+		maybePanic(err)
+		if string(got) != d.String() {
+			t.Errorf("%d: %s ≠ %s", int64(d), got, d.String())
+		}
+	}
+}
+
+func TestAppendTimeErrors(t *testing.T) {
+	for _, ti := range []time.Time{
+		time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(-1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.FixedZone("", 24*60*60)),
+	} {
+		_, wantErr := ti.MarshalJSON()
+		_, err := TimeFrom(ti).MarshalJSON()
+		if err == nil || wantErr == nil || err.Error() != wantErr.Error() {
+			t.Errorf("%v: MarshalJSON error %v ≠ %v", ti, err, wantErr)
+		}
+		_, wantErr = ti.MarshalText()
+		_, err = TimeFrom(ti).MarshalText()
+		if err == nil || wantErr == nil || err.Error() != wantErr.Error() {
+			t.Errorf("%v: MarshalText error %v ≠ %v", ti, err, wantErr)
+		}
+	}
+
+	if _, err := FloatFrom(math.NaN()).AppendJSON(nil); err == nil {
+		t.Error("expected error for NaN, got nil")
+	}
+}
+
+func TestAppendAllocs(t *testing.T) {
+	buf := make([]byte, 0, 128)
+	for _, v := range []any{
+		IntFrom(123456), Uint8From(1), FloatFrom(1.5), Float32From(0.1), StringFrom("<hello>"), BoolFrom(true),
+		Int{}, TimeFrom(time.Now()), DateFrom(2000, 1, 2), TimeOfDayFrom(1, 2, 3, 4), DurationFrom(time.Minute),
+		BytesFrom([]byte("hi")), UUIDFrom([16]byte{1}), AddrFrom(netip.MustParseAddr("192.0.2.1")),
+		AddrPortFrom(netip.MustParseAddrPort("[::1]:80")),
+		DecimalFrom(big.NewInt(-1250), 2), DecimalFrom(big.NewInt(5), 3), Decimal{Valid: true},
+		BigIntFrom(big.NewInt(-3)), BigInt{Valid: true}, BigIntString{BigIntFrom(big.NewInt(7))},
+	} {
+		if n := testing.AllocsPerRun(100, func() {
+			_, err := v.(jsonAppender).AppendJSON(buf[:0])
+			maybePanic(err)
+		}); n != 0 {
+			t.Errorf("%T: AppendJSON: %v allocations, want 0", v, n)
+		}
+		if n := testing.AllocsPerRun(100, func() {
+			_, err := v.(textAppender).AppendText(buf[:0])
+			maybePanic(err)
+		}); n != 0 {
+			t.Errorf("%T: AppendText: %v allocations, want 0", v, n)
+		}
+	}
+}
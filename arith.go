@@ -0,0 +1,604 @@
+package null
+
+import (
+	"errors"
+	"math"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// ErrOverflow is returned by the checked arithmetic methods, such as Int.AddChecked,
+// and by Sum, when the result does not fit into the type.
+var ErrOverflow = errors.New("null: integer overflow")
+
+// Arithmetic follows SQL semantics: if either operand is null, the result is null.
+// Division or modulo by zero also results in null, like MySQL and SQLite.
+// Integer methods wrap around on overflow like Go's operators do;
+// use the Checked variants to get ErrOverflow instead.
+
+// Add returns the sum of i and other, or null if either is null.
+func (i Int) Add(other Int) Int {
+	if !i.Valid || !other.Valid {
+		return Int{}
+	}
+	return IntFrom(i.Int64 + other.Int64)
+}
+
+// Sub returns the difference of i and other, or null if either is null.
+func (i Int) Sub(other Int) Int {
+	if !i.Valid || !other.Valid {
+		return Int{}
+	}
+	return IntFrom(i.Int64 - other.Int64)
+}
+
+// Mul returns the product of i and other, or null if either is null.
+func (i Int) Mul(other Int) Int {
+	if !i.Valid || !other.Valid {
+		return Int{}
+	}
+	return IntFrom(i.Int64 * other.Int64)
+}
+
+// Div returns the quotient of i and other, truncated towards zero.
+// It returns null if either is null or other is zero.
+func (i Int) Div(other Int) Int {
+	if !i.Valid || !other.Valid || other.Int64 == 0 {
+		return Int{}
+	}
+	return IntFrom(i.Int64 / other.Int64)
+}
+
+// Mod returns the remainder of i divided by other.
+// It returns null if either is null or other is zero.
+func (i Int) Mod(other Int) Int {
+	if !i.Valid || !other.Valid || other.Int64 == 0 {
+		return Int{}
+	}
+	return IntFrom(i.Int64 % other.Int64)
+}
+
+// Neg returns the negation of i, or null if i is null.
+func (i Int) Neg() Int {
+	if !i.Valid {
+		return Int{}
+	}
+	return IntFrom(-i.Int64)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+func (i Int) Abs() Int {
+	if !i.Valid || i.Int64 >= 0 {
+		return i
+	}
+	return IntFrom(-i.Int64)
+}
+
+// AddChecked is like Add, but returns ErrOverflow if the result overflows.
+func (i Int) AddChecked(other Int) (Int, error) {
+	return checkedInt(i, other, internal.AddChecked[int64])
+}
+
+// SubChecked is like Sub, but returns ErrOverflow if the result overflows.
+func (i Int) SubChecked(other Int) (Int, error) {
+	return checkedInt(i, other, internal.SubChecked[int64])
+}
+
+// MulChecked is like Mul, but returns ErrOverflow if the result overflows.
+func (i Int) MulChecked(other Int) (Int, error) {
+	return checkedInt(i, other, internal.MulChecked[int64])
+}
+
+// DivChecked is like Div, but returns ErrOverflow if the result overflows.
+func (i Int) DivChecked(other Int) (Int, error) {
+	if other.Valid && other.Int64 == 0 {
+		return Int{}, nil
+	}
+	return checkedInt(i, other, internal.DivChecked[int64])
+}
+
+// NegChecked is like Neg, but returns ErrOverflow if the result overflows.
+func (i Int) NegChecked() (Int, error) {
+	return checkedInt(i, IntFrom(0), func(a, _ int64) (int64, bool) {
+		return internal.NegChecked(a)
+	})
+}
+
+// AbsChecked is like Abs, but returns ErrOverflow if the result overflows.
+func (i Int) AbsChecked() (Int, error) {
+	return checkedInt(i, IntFrom(0), func(a, _ int64) (int64, bool) {
+		return internal.AbsChecked(a)
+	})
+}
+
+func checkedInt(a, b Int, op func(a, b int64) (int64, bool)) (Int, error) {
+	if !a.Valid || !b.Valid {
+		return Int{}, nil
+	}
+	n, ok := op(a.Int64, b.Int64)
+	if !ok {
+		return Int{}, ErrOverflow
+	}
+	return IntFrom(n), nil
+}
+
+// Add returns the sum of i and other, or null if either is null.
+func (i Int32) Add(other Int32) Int32 {
+	if !i.Valid || !other.Valid {
+		return Int32{}
+	}
+	return Int32From(i.Int32 + other.Int32)
+}
+
+// Sub returns the difference of i and other, or null if either is null.
+func (i Int32) Sub(other Int32) Int32 {
+	if !i.Valid || !other.Valid {
+		return Int32{}
+	}
+	return Int32From(i.Int32 - other.Int32)
+}
+
+// Mul returns the product of i and other, or null if either is null.
+func (i Int32) Mul(other Int32) Int32 {
+	if !i.Valid || !other.Valid {
+		return Int32{}
+	}
+	return Int32From(i.Int32 * other.Int32)
+}
+
+// Div returns the quotient of i and other, truncated towards zero.
+// It returns null if either is null or other is zero.
+func (i Int32) Div(other Int32) Int32 {
+	if !i.Valid || !other.Valid || other.Int32 == 0 {
+		return Int32{}
+	}
+	return Int32From(i.Int32 / other.Int32)
+}
+
+// Mod returns the remainder of i divided by other.
+// It returns null if either is null or other is zero.
+func (i Int32) Mod(other Int32) Int32 {
+	if !i.Valid || !other.Valid || other.Int32 == 0 {
+		return Int32{}
+	}
+	return Int32From(i.Int32 % other.Int32)
+}
+
+// Neg returns the negation of i, or null if i is null.
+func (i Int32) Neg() Int32 {
+	if !i.Valid {
+		return Int32{}
+	}
+	return Int32From(-i.Int32)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+func (i Int32) Abs() Int32 {
+	if !i.Valid || i.Int32 >= 0 {
+		return i
+	}
+	return Int32From(-i.Int32)
+}
+
+// AddChecked is like Add, but returns ErrOverflow if the result overflows.
+func (i Int32) AddChecked(other Int32) (Int32, error) {
+	return checkedInt32(i, other, internal.AddChecked[int32])
+}
+
+// SubChecked is like Sub, but returns ErrOverflow if the result overflows.
+func (i Int32) SubChecked(other Int32) (Int32, error) {
+	return checkedInt32(i, other, internal.SubChecked[int32])
+}
+
+// MulChecked is like Mul, but returns ErrOverflow if the result overflows.
+func (i Int32) MulChecked(other Int32) (Int32, error) {
+	return checkedInt32(i, other, internal.MulChecked[int32])
+}
+
+// DivChecked is like Div, but returns ErrOverflow if the result overflows.
+func (i Int32) DivChecked(other Int32) (Int32, error) {
+	if other.Valid && other.Int32 == 0 {
+		return Int32{}, nil
+	}
+	return checkedInt32(i, other, internal.DivChecked[int32])
+}
+
+// NegChecked is like Neg, but returns ErrOverflow if the result overflows.
+func (i Int32) NegChecked() (Int32, error) {
+	return checkedInt32(i, Int32From(0), func(a, _ int32) (int32, bool) {
+		return internal.NegChecked(a)
+	})
+}
+
+// AbsChecked is like Abs, but returns ErrOverflow if the result overflows.
+func (i Int32) AbsChecked() (Int32, error) {
+	return checkedInt32(i, Int32From(0), func(a, _ int32) (int32, bool) {
+		return internal.AbsChecked(a)
+	})
+}
+
+func checkedInt32(a, b Int32, op func(a, b int32) (int32, bool)) (Int32, error) {
+	if !a.Valid || !b.Valid {
+		return Int32{}, nil
+	}
+	n, ok := op(a.Int32, b.Int32)
+	if !ok {
+		return Int32{}, ErrOverflow
+	}
+	return Int32From(n), nil
+}
+
+// Add returns the sum of i and other, or null if either is null.
+func (i Int16) Add(other Int16) Int16 {
+	if !i.Valid || !other.Valid {
+		return Int16{}
+	}
+	return Int16From(i.Int16 + other.Int16)
+}
+
+// Sub returns the difference of i and other, or null if either is null.
+func (i Int16) Sub(other Int16) Int16 {
+	if !i.Valid || !other.Valid {
+		return Int16{}
+	}
+	return Int16From(i.Int16 - other.Int16)
+}
+
+// Mul returns the product of i and other, or null if either is null.
+func (i Int16) Mul(other Int16) Int16 {
+	if !i.Valid || !other.Valid {
+		return Int16{}
+	}
+	return Int16From(i.Int16 * other.Int16)
+}
+
+// Div returns the quotient of i and other, truncated towards zero.
+// It returns null if either is null or other is zero.
+func (i Int16) Div(other Int16) Int16 {
+	if !i.Valid || !other.Valid || other.Int16 == 0 {
+		return Int16{}
+	}
+	return Int16From(i.Int16 / other.Int16)
+}
+
+// Mod returns the remainder of i divided by other.
+// It returns null if either is null or other is zero.
+func (i Int16) Mod(other Int16) Int16 {
+	if !i.Valid || !other.Valid || other.Int16 == 0 {
+		return Int16{}
+	}
+	return Int16From(i.Int16 % other.Int16)
+}
+
+// Neg returns the negation of i, or null if i is null.
+func (i Int16) Neg() Int16 {
+	if !i.Valid {
+		return Int16{}
+	}
+	return Int16From(-i.Int16)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+func (i Int16) Abs() Int16 {
+	if !i.Valid || i.Int16 >= 0 {
+		return i
+	}
+	return Int16From(-i.Int16)
+}
+
+// AddChecked is like Add, but returns ErrOverflow if the result overflows.
+func (i Int16) AddChecked(other Int16) (Int16, error) {
+	return checkedInt16(i, other, internal.AddChecked[int16])
+}
+
+// SubChecked is like Sub, but returns ErrOverflow if the result overflows.
+func (i Int16) SubChecked(other Int16) (Int16, error) {
+	return checkedInt16(i, other, internal.SubChecked[int16])
+}
+
+// MulChecked is like Mul, but returns ErrOverflow if the result overflows.
+func (i Int16) MulChecked(other Int16) (Int16, error) {
+	return checkedInt16(i, other, internal.MulChecked[int16])
+}
+
+// DivChecked is like Div, but returns ErrOverflow if the result overflows.
+func (i Int16) DivChecked(other Int16) (Int16, error) {
+	if other.Valid && other.Int16 == 0 {
+		return Int16{}, nil
+	}
+	return checkedInt16(i, other, internal.DivChecked[int16])
+}
+
+// NegChecked is like Neg, but returns ErrOverflow if the result overflows.
+func (i Int16) NegChecked() (Int16, error) {
+	return checkedInt16(i, Int16From(0), func(a, _ int16) (int16, bool) {
+		return internal.NegChecked(a)
+	})
+}
+
+// AbsChecked is like Abs, but returns ErrOverflow if the result overflows.
+func (i Int16) AbsChecked() (Int16, error) {
+	return checkedInt16(i, Int16From(0), func(a, _ int16) (int16, bool) {
+		return internal.AbsChecked(a)
+	})
+}
+
+func checkedInt16(a, b Int16, op func(a, b int16) (int16, bool)) (Int16, error) {
+	if !a.Valid || !b.Valid {
+		return Int16{}, nil
+	}
+	n, ok := op(a.Int16, b.Int16)
+	if !ok {
+		return Int16{}, ErrOverflow
+	}
+	return Int16From(n), nil
+}
+
+// Add returns the sum of i and other, or null if either is null.
+func (i Int8) Add(other Int8) Int8 {
+	if !i.Valid || !other.Valid {
+		return Int8{}
+	}
+	return Int8From(i.Int8 + other.Int8)
+}
+
+// Sub returns the difference of i and other, or null if either is null.
+func (i Int8) Sub(other Int8) Int8 {
+	if !i.Valid || !other.Valid {
+		return Int8{}
+	}
+	return Int8From(i.Int8 - other.Int8)
+}
+
+// Mul returns the product of i and other, or null if either is null.
+func (i Int8) Mul(other Int8) Int8 {
+	if !i.Valid || !other.Valid {
+		return Int8{}
+	}
+	return Int8From(i.Int8 * other.Int8)
+}
+
+// Div returns the quotient of i and other, truncated towards zero.
+// It returns null if either is null or other is zero.
+func (i Int8) Div(other Int8) Int8 {
+	if !i.Valid || !other.Valid || other.Int8 == 0 {
+		return Int8{}
+	}
+	return Int8From(i.Int8 / other.Int8)
+}
+
+// Mod returns the remainder of i divided by other.
+// It returns null if either is null or other is zero.
+func (i Int8) Mod(other Int8) Int8 {
+	if !i.Valid || !other.Valid || other.Int8 == 0 {
+		return Int8{}
+	}
+	return Int8From(i.Int8 % other.Int8)
+}
+
+// Neg returns the negation of i, or null if i is null.
+func (i Int8) Neg() Int8 {
+	if !i.Valid {
+		return Int8{}
+	}
+	return Int8From(-i.Int8)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+func (i Int8) Abs() Int8 {
+	if !i.Valid || i.Int8 >= 0 {
+		return i
+	}
+	return Int8From(-i.Int8)
+}
+
+// AddChecked is like Add, but returns ErrOverflow if the result overflows.
+func (i Int8) AddChecked(other Int8) (Int8, error) {
+	return checkedInt8(i, other, internal.AddChecked[int8])
+}
+
+// SubChecked is like Sub, but returns ErrOverflow if the result overflows.
+func (i Int8) SubChecked(other Int8) (Int8, error) {
+	return checkedInt8(i, other, internal.SubChecked[int8])
+}
+
+// MulChecked is like Mul, but returns ErrOverflow if the result overflows.
+func (i Int8) MulChecked(other Int8) (Int8, error) {
+	return checkedInt8(i, other, internal.MulChecked[int8])
+}
+
+// DivChecked is like Div, but returns ErrOverflow if the result overflows.
+func (i Int8) DivChecked(other Int8) (Int8, error) {
+	if other.Valid && other.Int8 == 0 {
+		return Int8{}, nil
+	}
+	return checkedInt8(i, other, internal.DivChecked[int8])
+}
+
+// NegChecked is like Neg, but returns ErrOverflow if the result overflows.
+func (i Int8) NegChecked() (Int8, error) {
+	return checkedInt8(i, Int8From(0), func(a, _ int8) (int8, bool) {
+		return internal.NegChecked(a)
+	})
+}
+
+// AbsChecked is like Abs, but returns ErrOverflow if the result overflows.
+func (i Int8) AbsChecked() (Int8, error) {
+	return checkedInt8(i, Int8From(0), func(a, _ int8) (int8, bool) {
+		return internal.AbsChecked(a)
+	})
+}
+
+func checkedInt8(a, b Int8, op func(a, b int8) (int8, bool)) (Int8, error) {
+	if !a.Valid || !b.Valid {
+		return Int8{}, nil
+	}
+	n, ok := op(a.Int8, b.Int8)
+	if !ok {
+		return Int8{}, ErrOverflow
+	}
+	return Int8From(n), nil
+}
+
+// Add returns the sum of b and other, or null if either is null.
+func (b Byte) Add(other Byte) Byte {
+	if !b.Valid || !other.Valid {
+		return Byte{}
+	}
+	return ByteFrom(b.Byte + other.Byte)
+}
+
+// Sub returns the difference of b and other, or null if either is null.
+func (b Byte) Sub(other Byte) Byte {
+	if !b.Valid || !other.Valid {
+		return Byte{}
+	}
+	return ByteFrom(b.Byte - other.Byte)
+}
+
+// Mul returns the product of b and other, or null if either is null.
+func (b Byte) Mul(other Byte) Byte {
+	if !b.Valid || !other.Valid {
+		return Byte{}
+	}
+	return ByteFrom(b.Byte * other.Byte)
+}
+
+// Div returns the quotient of b and other, truncated towards zero.
+// It returns null if either is null or other is zero.
+func (b Byte) Div(other Byte) Byte {
+	if !b.Valid || !other.Valid || other.Byte == 0 {
+		return Byte{}
+	}
+	return ByteFrom(b.Byte / other.Byte)
+}
+
+// Mod returns the remainder of b divided by other.
+// It returns null if either is null or other is zero.
+func (b Byte) Mod(other Byte) Byte {
+	if !b.Valid || !other.Valid || other.Byte == 0 {
+		return Byte{}
+	}
+	return ByteFrom(b.Byte % other.Byte)
+}
+
+// Neg returns the negation of b, or null if b is null.
+// Because Byte is unsigned, the negation of any non-zero value overflows.
+func (b Byte) Neg() Byte {
+	if !b.Valid {
+		return Byte{}
+	}
+	return ByteFrom(-b.Byte)
+}
+
+// Abs returns the absolute value of b, or null if b is null.
+// Because Byte is unsigned, this is always b itself.
+func (b Byte) Abs() Byte {
+	return b
+}
+
+// AddChecked is like Add, but returns ErrOverflow if the result overflows.
+func (b Byte) AddChecked(other Byte) (Byte, error) {
+	return checkedByte(b, other, internal.AddChecked[byte])
+}
+
+// SubChecked is like Sub, but returns ErrOverflow if the result overflows.
+func (b Byte) SubChecked(other Byte) (Byte, error) {
+	return checkedByte(b, other, internal.SubChecked[byte])
+}
+
+// MulChecked is like Mul, but returns ErrOverflow if the result overflows.
+func (b Byte) MulChecked(other Byte) (Byte, error) {
+	return checkedByte(b, other, internal.MulChecked[byte])
+}
+
+// DivChecked is like Div, but returns ErrOverflow if the result overflows.
+func (b Byte) DivChecked(other Byte) (Byte, error) {
+	if other.Valid && other.Byte == 0 {
+		return Byte{}, nil
+	}
+	return checkedByte(b, other, internal.DivChecked[byte])
+}
+
+// NegChecked is like Neg, but returns ErrOverflow if the result overflows.
+func (b Byte) NegChecked() (Byte, error) {
+	return checkedByte(b, ByteFrom(0), func(a, _ byte) (byte, bool) {
+		return internal.NegChecked(a)
+	})
+}
+
+// AbsChecked is like Abs, but returns ErrOverflow if the result overflows.
+func (b Byte) AbsChecked() (Byte, error) {
+	return checkedByte(b, ByteFrom(0), func(a, _ byte) (byte, bool) {
+		return internal.AbsChecked(a)
+	})
+}
+
+func checkedByte(a, b Byte, op func(a, b byte) (byte, bool)) (Byte, error) {
+	if !a.Valid || !b.Valid {
+		return Byte{}, nil
+	}
+	n, ok := op(a.Byte, b.Byte)
+	if !ok {
+		return Byte{}, ErrOverflow
+	}
+	return ByteFrom(n), nil
+}
+
+// Add returns the sum of f and other, or null if either is null.
+func (f Float) Add(other Float) Float {
+	if !f.Valid || !other.Valid {
+		return Float{}
+	}
+	return FloatFrom(f.Float64 + other.Float64)
+}
+
+// Sub returns the difference of f and other, or null if either is null.
+func (f Float) Sub(other Float) Float {
+	if !f.Valid || !other.Valid {
+		return Float{}
+	}
+	return FloatFrom(f.Float64 - other.Float64)
+}
+
+// Mul returns the product of f and other, or null if either is null.
+func (f Float) Mul(other Float) Float {
+	if !f.Valid || !other.Valid {
+		return Float{}
+	}
+	return FloatFrom(f.Float64 * other.Float64)
+}
+
+// Div returns the quotient of f and other.
+// It returns null if either is null or other is zero, instead of infinity.
+func (f Float) Div(other Float) Float {
+	if !f.Valid || !other.Valid || other.Float64 == 0 {
+		return Float{}
+	}
+	return FloatFrom(f.Float64 / other.Float64)
+}
+
+// Mod returns the floating-point remainder of f divided by other, as math.Mod does.
+// It returns null if either is null or other is zero.
+func (f Float) Mod(other Float) Float {
+	if !f.Valid || !other.Valid || other.Float64 == 0 {
+		return Float{}
+	}
+	return FloatFrom(math.Mod(f.Float64, other.Float64))
+}
+
+// Neg returns the negation of f, or null if f is null.
+func (f Float) Neg() Float {
+	if !f.Valid {
+		return Float{}
+	}
+	return FloatFrom(-f.Float64)
+}
+
+// Abs returns the absolute value of f, or null if f is null.
+func (f Float) Abs() Float {
+	if !f.Valid {
+		return Float{}
+	}
+	return FloatFrom(math.Abs(f.Float64))
+}
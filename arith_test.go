@@ -0,0 +1,157 @@
+package null
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+type arithmetic[N any] interface {
+	Add(N) N
+	Sub(N) N
+	Mul(N) N
+	Div(N) N
+	Mod(N) N
+	Neg() N
+	Abs() N
+	Equal(N) bool
+}
+
+func TestIntArithmetic(t *testing.T) {
+	testIntArithmetic(t, NewInt)
+	testIntArithmetic(t, NewInt32)
+	testIntArithmetic(t, NewInt16)
+	testIntArithmetic(t, NewInt8)
+}
+
+func testIntArithmetic[N arithmetic[N], V internal.Integer](t *testing.T, newInt func(V, bool) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		from := func(v V) N { return newInt(v, true) }
+		null := newInt(0, false)
+		seven, two := from(7), from(2)
+		neg := seven.Neg()
+
+		assertArith(t, seven.Add(two), from(9), "7 + 2")
+		assertArith(t, seven.Sub(two), from(5), "7 - 2")
+		assertArith(t, seven.Mul(two), from(14), "7 * 2")
+		assertArith(t, seven.Div(two), from(3), "7 / 2")
+		assertArith(t, neg.Div(two), from(3).Neg(), "-7 / 2")
+		assertArith(t, seven.Mod(two), from(1), "7 % 2")
+		assertArith(t, neg.Abs(), seven, "abs(-7)")
+		assertArith(t, seven.Abs(), seven, "abs(7)")
+		assertArith(t, neg.Neg(), seven, "-(-7)")
+
+		// null propagation
+		assertArith(t, seven.Add(null), null, "7 + null")
+		assertArith(t, null.Sub(seven), null, "null - 7")
+		assertArith(t, null.Mul(null), null, "null * null")
+		assertArith(t, seven.Div(null), null, "7 / null")
+		assertArith(t, seven.Mod(null), null, "7 % null")
+		assertArith(t, null.Neg(), null, "-null")
+		assertArith(t, null.Abs(), null, "abs(null)")
+
+		// division by zero
+		assertArith(t, seven.Div(from(0)), null, "7 / 0")
+		assertArith(t, seven.Mod(from(0)), null, "7 % 0")
+	})
+}
+
+func TestIntArithmeticChecked(t *testing.T) {
+	testIntArithmeticChecked(t, NewInt, math.MinInt64, math.MaxInt64)
+	testIntArithmeticChecked(t, NewInt32, math.MinInt32, math.MaxInt32)
+	testIntArithmeticChecked(t, NewInt16, math.MinInt16, math.MaxInt16)
+	testIntArithmeticChecked(t, NewInt8, math.MinInt8, math.MaxInt8)
+}
+
+type checkedArithmetic[N any] interface {
+	AddChecked(N) (N, error)
+	SubChecked(N) (N, error)
+	MulChecked(N) (N, error)
+	DivChecked(N) (N, error)
+	NegChecked() (N, error)
+	AbsChecked() (N, error)
+	Equal(N) bool
+}
+
+func testIntArithmeticChecked[N checkedArithmetic[N], V int64 | int32 | int16 | int8](t *testing.T, newInt func(V, bool) N, min, max V) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		from := func(v V) N { return newInt(v, true) }
+		null := newInt(0, false)
+		one, minusOne := from(1), from(-1)
+
+		assertChecked(t, from(max), N.AddChecked, one, null, ErrOverflow, "max + 1")
+		assertChecked(t, from(min), N.AddChecked, minusOne, null, ErrOverflow, "min + -1")
+		assertChecked(t, from(max), N.AddChecked, minusOne, from(max-1), nil, "max + -1")
+		assertChecked(t, from(min), N.SubChecked, one, null, ErrOverflow, "min - 1")
+		assertChecked(t, from(max), N.SubChecked, minusOne, null, ErrOverflow, "max - -1")
+		assertChecked(t, from(min), N.SubChecked, minusOne, from(min+1), nil, "min - -1")
+		assertChecked(t, from(max), N.MulChecked, from(2), null, ErrOverflow, "max * 2")
+		assertChecked(t, from(min), N.MulChecked, minusOne, null, ErrOverflow, "min * -1")
+		assertChecked(t, minusOne, N.MulChecked, from(min), null, ErrOverflow, "-1 * min")
+		assertChecked(t, from(max), N.MulChecked, minusOne, from(-max), nil, "max * -1")
+		assertChecked(t, from(min), N.DivChecked, minusOne, null, ErrOverflow, "min / -1")
+		assertChecked(t, from(max), N.DivChecked, from(0), null, nil, "max / 0")
+		assertChecked(t, from(max), N.AddChecked, null, null, nil, "max + null")
+
+		neg := func(n, _ N) (N, error) { return n.NegChecked() }
+		abs := func(n, _ N) (N, error) { return n.AbsChecked() }
+		assertChecked(t, from(min), neg, null, null, ErrOverflow, "-min")
+		assertChecked(t, from(max), neg, null, from(-max), nil, "-max")
+		assertChecked(t, from(min), abs, null, null, ErrOverflow, "abs(min)")
+		assertChecked(t, from(-max), abs, null, from(max), nil, "abs(-max)")
+	})
+}
+
+func TestByteArithmetic(t *testing.T) {
+	assertArith(t, ByteFrom(200).Add(ByteFrom(100)), ByteFrom(44), "200 + 100 (wrapped)")
+	assertArith(t, ByteFrom(7).Div(ByteFrom(2)), ByteFrom(3), "7 / 2")
+	assertArith(t, ByteFrom(7).Abs(), ByteFrom(7), "abs(7)")
+	assertArith(t, ByteFrom(7).Div(ByteFrom(0)), Byte{}, "7 / 0")
+
+	assertChecked(t, ByteFrom(200), Byte.AddChecked, ByteFrom(100), Byte{}, ErrOverflow, "200 + 100")
+	assertChecked(t, ByteFrom(1), Byte.SubChecked, ByteFrom(2), Byte{}, ErrOverflow, "1 - 2")
+	assertChecked(t, ByteFrom(128), Byte.MulChecked, ByteFrom(2), Byte{}, ErrOverflow, "128 * 2")
+	assertChecked(t, ByteFrom(255), Byte.DivChecked, ByteFrom(255), ByteFrom(1), nil, "255 / 255")
+	assertChecked(t, ByteFrom(0), func(b, _ Byte) (Byte, error) { return b.NegChecked() }, Byte{}, ByteFrom(0), nil, "-0")
+	assertChecked(t, ByteFrom(1), func(b, _ Byte) (Byte, error) { return b.NegChecked() }, Byte{}, Byte{}, ErrOverflow, "-1")
+}
+
+func TestFloatArithmetic(t *testing.T) {
+	a, b := FloatFrom(7.5), FloatFrom(2)
+	null := Float{}
+
+	assertArith(t, a.Add(b), FloatFrom(9.5), "7.5 + 2")
+	assertArith(t, a.Sub(b), FloatFrom(5.5), "7.5 - 2")
+	assertArith(t, a.Mul(b), FloatFrom(15), "7.5 * 2")
+	assertArith(t, a.Div(b), FloatFrom(3.75), "7.5 / 2")
+	assertArith(t, a.Mod(b), FloatFrom(1.5), "7.5 % 2")
+	assertArith(t, a.Neg(), FloatFrom(-7.5), "-7.5")
+	assertArith(t, a.Neg().Abs(), a, "abs(-7.5)")
+
+	assertArith(t, a.Add(null), null, "7.5 + null")
+	assertArith(t, null.Mul(a), null, "null * 7.5")
+	assertArith(t, null.Neg(), null, "-null")
+	assertArith(t, null.Abs(), null, "abs(null)")
+	assertArith(t, a.Div(FloatFrom(0)), null, "7.5 / 0")
+	assertArith(t, a.Mod(FloatFrom(0)), null, "7.5 % 0")
+}
+
+func assertArith[N interface{ Equal(N) bool }](t *testing.T, got, want N, from string) {
+	t.Helper()
+	if !got.Equal(want) {
+		t.Errorf("bad %s: got %#v, want %#v", from, got, want)
+	}
+}
+
+func assertChecked[N interface{ Equal(N) bool }](t *testing.T, a N, op func(N, N) (N, error), b N, want N, wantErr error, from string) {
+	t.Helper()
+	got, err := op(a, b)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("bad %s error: got %v, want %v", from, err, wantErr)
+	}
+	if !got.Equal(want) {
+		t.Errorf("bad %s: got %#v, want %#v", from, got, want)
+	}
+}
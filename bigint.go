@@ -0,0 +1,216 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// BigInt is a nullable *big.Int, for integers that don't fit in an int64, such as NUMERIC(78,0) columns.
+// A valid BigInt with a nil Int is treated as 0.
+// It will marshal to null if null, and to a JSON number otherwise. Use BigIntString to marshal a string instead.
+type BigInt struct {
+	Int   *big.Int
+	Valid bool // Valid is true if Int is not NULL
+}
+
+// NewBigInt creates a new BigInt holding a copy of i.
+func NewBigInt(i *big.Int, valid bool) BigInt {
+	return BigInt{
+		Int:   copyBigInt(i),
+		Valid: valid,
+	}
+}
+
+// BigIntFrom creates a new BigInt holding a copy of i that will always be valid.
+func BigIntFrom(i *big.Int) BigInt {
+	return NewBigInt(i, true)
+}
+
+// BigIntFromPtr creates a new BigInt holding a copy of i that will be null if i is nil.
+func BigIntFromPtr(i *big.Int) BigInt {
+	return NewBigInt(i, i != nil)
+}
+
+// ValueOrZero returns a copy of the inner value if valid, otherwise a new big.Int equal to 0.
+func (b BigInt) ValueOrZero() *big.Int {
+	if !b.Valid {
+		return new(big.Int)
+	}
+	return copyBigInt(b.Int)
+}
+
+// ValueOr returns a copy of the inner value if valid, otherwise v.
+func (b BigInt) ValueOr(v *big.Int) *big.Int {
+	if !b.Valid {
+		return v
+	}
+	return copyBigInt(b.Int)
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts int64, uint64, and decimal strings as string or []byte.
+func (b *BigInt) Scan(src any) error {
+	if src == nil {
+		b.Int, b.Valid = nil, false
+		return nil
+	}
+	i, err := internal.ScanBigInt(src)
+	if err != nil {
+		b.Int, b.Valid = nil, false
+		return fmt.Errorf("null: couldn't scan BigInt: %w", err)
+	}
+	b.Int, b.Valid = i, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the decimal string, which databases convert to NUMERIC without loss of precision.
+func (b BigInt) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.value().String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input. Numbers are not converted to float64.
+func (b *BigInt) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		b.Int, b.Valid = nil, false
+		return nil
+	}
+	i, err := internal.UnmarshalBigIntJSON(data)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	b.Int, b.Valid = i, true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BigInt if the input is blank or "null".
+func (b *BigInt) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		b.Int, b.Valid = nil, false
+		return nil
+	}
+	i, err := internal.ParseBigInt(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	b.Int, b.Valid = i, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this BigInt is null, otherwise a number.
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this BigInt to dst, as MarshalJSON does.
+// It will append null if this BigInt is null.
+func (b BigInt) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, "null"...), nil
+	}
+	return internal.AppendBigIntJSON(dst, b.value(), false), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this BigInt is null.
+func (b BigInt) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this BigInt is null.
+func (b BigInt) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return dst, nil
+	}
+	return internal.AppendBigInt(dst, b.value()), nil
+}
+
+// SetValid changes this BigInt's value to a copy of v and also sets it to be non-null.
+func (b *BigInt) SetValid(v *big.Int) {
+	b.Int = copyBigInt(v)
+	b.Valid = true
+}
+
+// Ptr returns a copy of this BigInt's value, or a nil pointer if this BigInt is null.
+func (b BigInt) Ptr() *big.Int {
+	if !b.Valid {
+		return nil
+	}
+	return copyBigInt(b.Int)
+}
+
+// IsZero returns true for null BigInts.
+// A non-null BigInt with a zero value will not be considered zero.
+func (b BigInt) IsZero() bool {
+	return !b.Valid
+}
+
+// Equal returns true if both BigInts have the same value or are both null.
+func (b BigInt) Equal(other BigInt) bool {
+	return b.Valid == other.Valid && (!b.Valid || b.value().Cmp(other.value()) == 0)
+}
+
+// Compare returns an integer comparing two BigInts, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (b BigInt) Compare(other BigInt) int {
+	return internal.CompareFunc(b.value(), b.Valid, other.value(), other.Valid, (*big.Int).Cmp)
+}
+
+// CmpNull compares b and other like big.Int's Cmp method,
+// returning -1, 0, or +1 if b is less than, equal to, or greater than other.
+// If either is null, the result is null. To sort BigInts, use Compare.
+func (b BigInt) CmpNull(other BigInt) Int {
+	if !b.Valid || !other.Valid {
+		return Int{}
+	}
+	return IntFrom(int64(b.value().Cmp(other.value())))
+}
+
+// value returns the inner value without copying, treating nil as 0.
+func (b BigInt) value() *big.Int {
+	if b.Int == nil {
+		return internal.BigZero
+	}
+	return b.Int
+}
+
+func copyBigInt(i *big.Int) *big.Int {
+	if i == nil {
+		return nil
+	}
+	return new(big.Int).Set(i)
+}
+
+// BigIntString is a BigInt that marshals to a JSON string such as "12345678901234567890",
+// so that JavaScript clients don't lose precision.
+// It is otherwise the same as BigInt, and can be made from one with BigIntString{b}.
+// Like BigInt, it unmarshals from both numbers and strings.
+type BigIntString struct {
+	BigInt
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this BigIntString is null, otherwise a string.
+func (b BigIntString) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this BigIntString to dst, as MarshalJSON does.
+// It will append null if this BigIntString is null.
+func (b BigIntString) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, "null"...), nil
+	}
+	return internal.AppendBigIntJSON(dst, b.value(), true), nil
+}
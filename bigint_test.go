@@ -0,0 +1,190 @@
+package null
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+var (
+	bigIntString = "115792089237316195423570985008687907853269984665640564039457584007913129639935" // 2^256 - 1
+	bigIntJSON   = []byte(bigIntString)
+	bigIntValue  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+func TestBigIntFrom(t *testing.T) {
+	assertBigInt(t, BigIntFrom(bigIntValue), "BigIntFrom()")
+	assertBigInt(t, BigIntFromPtr(bigIntValue), "BigIntFromPtr()")
+	assertNullBigInt(t, BigIntFromPtr(nil), "BigIntFromPtr(nil)")
+
+	if zero := BigIntFrom(new(big.Int)); !zero.Valid {
+		t.Error("BigIntFrom(0)", "is invalid, but should be valid")
+	}
+
+	// constructors copy their input
+	n := big.NewInt(5)
+	b := BigIntFrom(n)
+	n.SetInt64(6)
+	if b.Int.Int64() != 5 {
+		t.Error("BigIntFrom() didn't copy its input:", b.Int)
+	}
+	b.ValueOrZero().SetInt64(7)
+	if b.Int.Int64() != 5 {
+		t.Error("ValueOrZero() didn't copy:", b.Int)
+	}
+}
+
+func TestBigIntScanValue(t *testing.T) {
+	for _, src := range []any{bigIntString, []byte(bigIntString)} {
+		var b BigInt
+		err := b.Scan(src)
+		maybePanic(err)
+		assertBigInt(t, b, "scanned")
+		v, err := b.Value()
+		maybePanic(err)
+		if v != bigIntString {
+			t.Errorf("bad value: %#v", v)
+		}
+	}
+
+	var i BigInt
+	maybePanic(i.Scan(int64(-42)))
+	if v, _ := i.Value(); v != "-42" {
+		t.Errorf("scanned int64: bad value: %#v", v)
+	}
+	var u BigInt
+	maybePanic(u.Scan(uint64(1 << 63)))
+	if v, _ := u.Value(); v != "9223372036854775808" {
+		t.Errorf("scanned uint64: bad value: %#v", v)
+	}
+
+	var null BigInt
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullBigInt(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Errorf("null value: %#v %v", v, err)
+	}
+
+	var bad BigInt
+	for _, src := range []any{"1.5", "abc", 1.5, true} {
+		if err := bad.Scan(src); err == nil {
+			t.Errorf("expected error scanning %#v", src)
+		}
+	}
+	assertNullBigInt(t, bad, "bad scan")
+}
+
+func TestBigIntJSON(t *testing.T) {
+	var b BigInt
+	err := json.Unmarshal(bigIntJSON, &b)
+	maybePanic(err)
+	assertBigInt(t, b, "number json")
+
+	var str BigInt
+	err = json.Unmarshal([]byte(`"`+bigIntString+`"`), &str)
+	maybePanic(err)
+	assertBigInt(t, str, "string json")
+
+	var null BigInt
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBigInt(t, null, "null json")
+
+	var bad BigInt
+	for _, data := range [][]byte{invalidJSON, boolJSON, []byte(`1.5`), []byte(`1e3`), []byte(`""`)} {
+		if err := json.Unmarshal(data, &bad); err == nil {
+			t.Errorf("expected error unmarshaling %s", data)
+		}
+	}
+
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, bigIntString, "number json marshal")
+	data, err = json.Marshal(BigInt{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = json.Marshal(BigIntString{b})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+bigIntString+`"`, "string json marshal")
+	data, err = json.Marshal(BigIntString{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null string json marshal")
+
+	var bs BigIntString
+	err = json.Unmarshal([]byte(`"`+bigIntString+`"`), &bs)
+	maybePanic(err)
+	assertBigInt(t, bs.BigInt, "string json unmarshal")
+}
+
+func TestBigIntText(t *testing.T) {
+	var b BigInt
+	err := b.UnmarshalText([]byte(bigIntString))
+	maybePanic(err)
+	assertBigInt(t, b, "text")
+
+	data, err := b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, bigIntString, "text marshal")
+	data, err = BigInt{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var null BigInt
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullBigInt(t, null, `"null" text`)
+}
+
+func TestBigIntValidNil(t *testing.T) {
+	b := BigInt{Valid: true}
+	if v, _ := b.Value(); v != "0" {
+		t.Errorf("valid nil value: %#v", v)
+	}
+	if !b.Equal(BigIntFrom(new(big.Int))) {
+		t.Error("valid nil should equal 0")
+	}
+}
+
+func TestBigIntCompare(t *testing.T) {
+	one, two := BigIntFrom(big.NewInt(1)), BigIntFrom(big.NewInt(2))
+	if !one.Equal(BigIntFrom(big.NewInt(1))) {
+		t.Error("1 should equal 1")
+	}
+	if one.Equal(two) || one.Equal(BigInt{}) {
+		t.Error("1 should not equal 2 or null")
+	}
+	if !(BigInt{}).Equal(BigInt{}) {
+		t.Error("null should equal null")
+	}
+
+	assertCompare(t, one.Compare(two), -1, "1 vs 2")
+	assertCompare(t, two.Compare(one), 1, "2 vs 1")
+	assertCompare(t, BigInt{}.Compare(BigIntFrom(big.NewInt(-1))), -1, "null vs -1")
+	assertCompare(t, BigInt{}.Compare(BigInt{}), 0, "null vs null")
+
+	if got := one.CmpNull(two); got != IntFrom(-1) {
+		t.Error("1 CmpNull 2:", got)
+	}
+	if got := one.CmpNull(BigInt{}); got.Valid {
+		t.Error("CmpNull(null) should be null")
+	}
+}
+
+func assertBigInt(t *testing.T, b BigInt, from string) {
+	t.Helper()
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+	if b.Int.Cmp(bigIntValue) != 0 {
+		t.Errorf("bad %s big int: %v ≠ %v\n", from, b.Int, bigIntValue)
+	}
+}
+
+func assertNullBigInt(t *testing.T, b BigInt, from string) {
+	t.Helper()
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
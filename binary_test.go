@@ -0,0 +1,217 @@
+package null
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"math"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// binaryTypes is every type in this package, which should all support encoding.BinaryMarshaler and gob.
+var binaryTypes = []any{
+	String{}, Int{}, Int32{}, Int16{}, Int8{}, Byte{}, Uint{}, Uint32{}, Uint16{}, Uint8{},
+	Float{}, Float32{}, Decimal{}, BigInt{}, Bool{}, Time{}, Date{}, TimeOfDay{}, Duration{},
+	JSON{}, Bytes{}, UUID{}, Addr{}, Prefix{}, AddrPort{},
+	Value[int]{}, JSONValue[int]{}, Optional[int]{},
+}
+
+func TestBinaryInterfaces(t *testing.T) {
+	for _, v := range binaryTypes {
+		if _, ok := v.(encoding.BinaryMarshaler); !ok {
+			t.Errorf("%T doesn't implement encoding.BinaryMarshaler", v)
+		}
+		if _, ok := v.(gob.GobEncoder); !ok {
+			t.Errorf("%T doesn't implement gob.GobEncoder", v)
+		}
+		ptr := reflect.New(reflect.TypeOf(v)).Interface()
+		if _, ok := ptr.(encoding.BinaryUnmarshaler); !ok {
+			t.Errorf("%T doesn't implement encoding.BinaryUnmarshaler", v)
+		}
+		if _, ok := ptr.(gob.GobDecoder); !ok {
+			t.Errorf("%T doesn't implement gob.GobDecoder", v)
+		}
+	}
+}
+
+type binaryRecord struct {
+	ID      Int
+	Small   Uint8
+	Name    String
+	Score   Float32
+	Price   Decimal
+	Big     BigInt
+	Active  Bool
+	Born    Date
+	Seen    Time
+	Alarm   TimeOfDay
+	Wait    Duration
+	Doc     JSON
+	Raw     Bytes
+	UUID    UUID
+	IP      Addr
+	Net     Prefix
+	Tags    Value[[]int]
+	When    JSONValue[Date]
+	Rank    Optional[int]
+	Missing Optional[int]
+}
+
+func TestBinaryGobRoundTrip(t *testing.T) {
+	in := binaryRecord{
+		ID:     IntFrom(-1),
+		Small:  Uint8From(255),
+		Name:   StringFrom("<test>"),
+		Score:  Float32From(0.1),
+		Price:  DecimalFrom(big.NewInt(-150), 2),
+		Big:    BigIntFrom(new(big.Int).Lsh(big.NewInt(1), 100)),
+		Active: BoolFrom(false),
+		Born:   DateFrom(-44, time.March, 15),
+		Seen:   TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 5, time.FixedZone("JST", 9*60*60))),
+		Alarm:  TimeOfDayFrom(23, 59, 59, 999999999),
+		Wait:   DurationFrom(-90 * time.Second),
+		Doc:    JSONFrom([]byte(`{"a":1}`)),
+		Raw:    BytesFrom([]byte{0, 1, 2}),
+		UUID:   UUIDFrom([16]byte{15: 1}),
+		IP:     AddrFrom(netip.MustParseAddr("2001:db8::1")),
+		Net:    PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")),
+		Tags:   ValueFrom([]int{1, 2}),
+		When:   JSONValueFrom(DateFrom(2000, time.January, 2)),
+		Rank:   OptionalNull[int](),
+	}
+	var buf bytes.Buffer
+	maybePanic(gob.NewEncoder(&buf).Encode(in))
+
+	var out binaryRecord
+	maybePanic(gob.NewDecoder(&buf).Decode(&out))
+	if !out.ID.Equal(in.ID) || !out.Small.Equal(in.Small) || !out.Name.Equal(in.Name) ||
+		!out.Score.Equal(in.Score) || !out.Price.Equal(in.Price) || out.Price.Scale() != 2 || !out.Big.Equal(in.Big) ||
+		!out.Active.Equal(in.Active) || !out.Born.Equal(in.Born) || !out.Seen.Equal(in.Seen) ||
+		!out.Alarm.Equal(in.Alarm) || !out.Wait.Equal(in.Wait) || !bytes.Equal(out.Doc.JSON, in.Doc.JSON) ||
+		!out.Raw.Equal(in.Raw) || !out.UUID.Equal(in.UUID) || !out.IP.Equal(in.IP) || !out.Net.Equal(in.Net) ||
+		len(out.Tags.V) != 2 || out.When != in.When || out.Rank != in.Rank || out.Missing.Present {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+	if _, offset := out.Seen.Time.Zone(); offset != 9*60*60 {
+		t.Errorf("bad zone offset: %d", offset)
+	}
+}
+
+func TestBinaryNull(t *testing.T) {
+	for _, v := range binaryTypes {
+		data, err := v.(encoding.BinaryMarshaler).MarshalBinary()
+		maybePanic(err)
+		want := []byte{0x10}
+		if _, ok := v.(Optional[int]); ok {
+			want = []byte{0x12}
+		}
+		if !bytes.Equal(data, want) {
+			t.Errorf("%T: null marshal: %#x ≠ %#x", v, data, want)
+		}
+
+		ptr := reflect.New(reflect.TypeOf(v))
+		maybePanic(ptr.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(data))
+		if !ptr.Elem().IsZero() {
+			t.Errorf("%T: null unmarshal: %+v", v, ptr.Elem())
+		}
+	}
+
+	i := IntFrom(1)
+	maybePanic(i.UnmarshalBinary([]byte{0x10}))
+	assertNullInt(t, i, "UnmarshalBinary() null")
+
+	o := OptionalFrom(1)
+	maybePanic(o.UnmarshalBinary([]byte{0x10}))
+	if !o.Present || o.Valid {
+		t.Errorf("null Optional should be present and null: %+v", o)
+	}
+}
+
+func TestBinaryFormat(t *testing.T) {
+	table := []struct {
+		v    encoding.BinaryMarshaler
+		want []byte
+	}{
+		{IntFrom(-1), []byte{0x11, 0x01}},
+		{IntFrom(64), []byte{0x11, 0x80, 0x01}},
+		{UintFrom(300), []byte{0x11, 0xac, 0x02}},
+		{ByteFrom('a'), []byte{0x11, 0x61}},
+		{FloatFrom(1), []byte{0x11, 0x3f, 0xf0, 0, 0, 0, 0, 0, 0}},
+		{Float32From(1), []byte{0x11, 0x3f, 0x80, 0, 0}},
+		{BoolFrom(true), []byte{0x11, 0x01}},
+		{StringFrom("hi"), []byte{0x11, 'h', 'i'}},
+		{StringFrom(""), []byte{0x11}},
+		{BigIntFrom(big.NewInt(-256)), []byte{0x11, 0x01, 0x01, 0x00}},
+		{DecimalFrom(big.NewInt(150), 2), []byte{0x11, 0x02, 0x00, 0x96}},
+		{DateFrom(2000, time.January, 2), []byte{0x11, 0xa0, 0x1f, 0x01, 0x02}},
+		{TimeOfDayFrom(0, 0, 1, 0), []byte{0x11, 0x80, 0x94, 0xeb, 0xdc, 0x03}},
+		{DurationFrom(-1), []byte{0x11, 0x01}},
+		{TimeFrom(time.Unix(1, 2).UTC()), []byte{0x11, 0x02, 0x02, 0x00}},
+		{TimeFrom(time.Unix(0, 0).In(time.FixedZone("", -60))), []byte{0x11, 0x00, 0x00, 0x77}},
+		{UUIDFrom([16]byte{0: 0xff}), append([]byte{0x11, 0xff}, make([]byte, 15)...)},
+		{AddrFrom(netip.MustParseAddr("192.0.2.1")), []byte{0x11, 192, 0, 2, 1}},
+		{ValueFrom([]int{1}), []byte{0x11, '[', '1', ']'}},
+		{ValueFrom(IntFrom(1)), []byte{0x11, 0x11, 0x02}},
+		{OptionalFrom(true), []byte{0x11, 't', 'r', 'u', 'e'}},
+	}
+	for _, test := range table {
+		data, err := test.v.MarshalBinary()
+		maybePanic(err)
+		if !bytes.Equal(data, test.want) {
+			t.Errorf("%T %v: %#x ≠ %#x", test.v, test.v, data, test.want)
+		}
+
+		ptr := reflect.New(reflect.TypeOf(test.v))
+		maybePanic(ptr.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(data))
+		again, err := ptr.Elem().Interface().(encoding.BinaryMarshaler).MarshalBinary()
+		maybePanic(err)
+		if !bytes.Equal(again, data) {
+			t.Errorf("%T %v: round trip: %#x ≠ %#x", test.v, test.v, again, data)
+		}
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	table := []struct {
+		v    encoding.BinaryUnmarshaler
+		data []byte
+	}{
+		{new(Int), nil},
+		{new(Int), []byte{0x21, 0x02}},
+		{new(Int), []byte{0x15, 0x02}},
+		{new(Int), []byte{0x10, 0x02}},
+		{new(Int), []byte{0x12}},
+		{new(Int), []byte{0x11}},
+		{new(Int), []byte{0x11, 0x02, 0x02}},
+		{new(Int), []byte{0x11, 0x80}},
+		{new(Uint8), []byte{0x11, 0x80, 0x02}},
+		{new(Int8), []byte{0x11, 0x80, 0x02}},
+		{new(Float), []byte{0x11, 0x00}},
+		{new(Float32), []byte{0x11, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{new(Bool), []byte{0x11, 0x02}},
+		{new(BigInt), []byte{0x11}},
+		{new(BigInt), []byte{0x11, 0x02, 0x01}},
+		{new(Decimal), append(binary.AppendUvarint([]byte{0x11}, math.MaxInt32+1), 0x00, 0x01)},
+		{new(Decimal), append(binary.AppendUvarint([]byte{0x11}, math.MaxInt32), 0x00, 0x01)},
+		{new(Decimal), append(binary.AppendUvarint([]byte{0x11}, 131072+1), 0x00, 0x01)},
+		{new(Date), []byte{0x11, 0xa0, 0x1f, 13, 1}},
+		{new(Date), []byte{0x11, 0xa0, 0x1f, 2, 30}},
+		{new(TimeOfDay), binary.AppendUvarint([]byte{0x11}, uint64(24*time.Hour)+1)},
+		{new(Time), append(binary.AppendUvarint([]byte{0x11, 0x00}, uint64(time.Second)), 0x00)},
+		{new(Time), []byte{0x11, 0x00, 0x00}},
+		{new(UUID), []byte{0x11, 0x01}},
+		{new(Addr), []byte{0x11, 0x01}},
+		{new(Value[int]), []byte{0x11, 'x'}},
+		{new(Value[int]), []byte{0x12}},
+	}
+	for _, test := range table {
+		if err := test.v.UnmarshalBinary(test.data); err == nil {
+			t.Errorf("%T: expected error for %#x", test.v, test.data)
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 // Bool is a nullable bool.
@@ -59,7 +62,9 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &b.Bool); err != nil {
+	if v, ok := internal.ParseJSONBool(data); ok {
+		b.Bool = v
+	} else if err := json.Unmarshal(data, &b.Bool); err != nil {
 		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
 	}
 
@@ -90,25 +95,31 @@ func (b *Bool) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
 func (b Bool) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Bool to dst, as MarshalJSON does.
+// It will append null if this Bool is null.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
 	if !b.Valid {
-		return []byte("null"), nil
-	}
-	if !b.Bool {
-		return []byte("false"), nil
+		return append(dst, "null"...), nil
 	}
-	return []byte("true"), nil
+	return strconv.AppendBool(dst, b.Bool), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Bool is null.
+func (b Bool) AppendText(dst []byte) ([]byte, error) {
 	if !b.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	if !b.Bool {
-		return []byte("false"), nil
-	}
-	return []byte("true"), nil
+	return strconv.AppendBool(dst, b.Bool), nil
 }
 
 // SetValid changes this Bool's value and also sets it to be non-null.
@@ -135,3 +146,83 @@ func (b Bool) IsZero() bool {
 func (b Bool) Equal(other Bool) bool {
 	return b.Valid == other.Valid && (!b.Valid || b.Bool == other.Bool)
 }
+
+// Compare returns an integer comparing two Bools, following the conventions of cmp.Compare.
+// false is less than true, and null is less than any non-null value.
+func (b Bool) Compare(other Bool) int {
+	return internal.CompareFunc(b.Bool, b.Valid, other.Bool, other.Valid, internal.CompareBool)
+}
+
+// And returns the logical conjunction of b and other using SQL's three-valued logic.
+// The result is false if either operand is false, even if the other is null.
+// Otherwise, the result is null if either operand is null.
+func (b Bool) And(other Bool) Bool {
+	switch {
+	case b.Valid && !b.Bool, other.Valid && !other.Bool:
+		return BoolFrom(false)
+	case !b.Valid, !other.Valid:
+		return Bool{}
+	}
+	return BoolFrom(true)
+}
+
+// Or returns the logical disjunction of b and other using SQL's three-valued logic.
+// The result is true if either operand is true, even if the other is null.
+// Otherwise, the result is null if either operand is null.
+func (b Bool) Or(other Bool) Bool {
+	switch {
+	case b.Valid && b.Bool, other.Valid && other.Bool:
+		return BoolFrom(true)
+	case !b.Valid, !other.Valid:
+		return Bool{}
+	}
+	return BoolFrom(false)
+}
+
+// Not returns the logical negation of b.
+// The negation of null is null.
+func (b Bool) Not() Bool {
+	if !b.Valid {
+		return Bool{}
+	}
+	return BoolFrom(!b.Bool)
+}
+
+// Xor returns the exclusive disjunction of b and other.
+// The result is null if either operand is null.
+func (b Bool) Xor(other Bool) Bool {
+	if !b.Valid || !other.Valid {
+		return Bool{}
+	}
+	return BoolFrom(b.Bool != other.Bool)
+}
+
+// Implies returns the material implication of b and other, equivalent to (NOT b) OR other.
+// The result is true if b is false or other is true, even if the other operand is null.
+func (b Bool) Implies(other Bool) Bool {
+	return b.Not().Or(other)
+}
+
+// AllBool returns the conjunction of every Bool using SQL's three-valued logic,
+// like chaining them with AND.
+// It returns false if any are false, otherwise null if any are null, otherwise true.
+// AllBool of no arguments is true.
+func AllBool(bs ...Bool) Bool {
+	result := BoolFrom(true)
+	for _, b := range bs {
+		result = result.And(b)
+	}
+	return result
+}
+
+// AnyBool returns the disjunction of every Bool using SQL's three-valued logic,
+// like chaining them with OR.
+// It returns true if any are true, otherwise null if any are null, otherwise false.
+// AnyBool of no arguments is false.
+func AnyBool(bs ...Bool) Bool {
+	result := BoolFrom(false)
+	for _, b := range bs {
+		result = result.Or(b)
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package null
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -229,6 +230,56 @@ func TestBoolEqual(t *testing.T) {
 	assertBoolEqualIsFalse(t, b1, b2)
 }
 
+func TestBoolLogic(t *testing.T) {
+	T, F, N := BoolFrom(true), BoolFrom(false), Bool{}
+	table := []struct {
+		a, b                  Bool
+		and, or, xor, implies Bool
+	}{
+		{T, T, T, T, F, T},
+		{T, F, F, T, T, F},
+		{T, N, N, T, N, N},
+		{F, T, F, T, T, T},
+		{F, F, F, F, F, T},
+		{F, N, F, N, N, T},
+		{N, T, N, T, N, T},
+		{N, F, F, N, N, N},
+		{N, N, N, N, N, N},
+	}
+	for _, tc := range table {
+		name := fmt.Sprintf("%s %s", boolString(tc.a), boolString(tc.b))
+		assertBoolExactEqual(t, tc.a.And(tc.b), tc.and, name+" AND")
+		assertBoolExactEqual(t, tc.a.Or(tc.b), tc.or, name+" OR")
+		assertBoolExactEqual(t, tc.a.Xor(tc.b), tc.xor, name+" XOR")
+		assertBoolExactEqual(t, tc.a.Implies(tc.b), tc.implies, name+" IMPLIES")
+	}
+
+	assertBoolExactEqual(t, T.Not(), F, "NOT true")
+	assertBoolExactEqual(t, F.Not(), T, "NOT false")
+	assertBoolExactEqual(t, N.Not(), N, "NOT null")
+
+	assertBoolExactEqual(t, AllBool(), T, "AllBool()")
+	assertBoolExactEqual(t, AllBool(T, T), T, "AllBool(true, true)")
+	assertBoolExactEqual(t, AllBool(T, N), N, "AllBool(true, null)")
+	assertBoolExactEqual(t, AllBool(N, F, T), F, "AllBool(null, false, true)")
+	assertBoolExactEqual(t, AnyBool(), F, "AnyBool()")
+	assertBoolExactEqual(t, AnyBool(F, F), F, "AnyBool(false, false)")
+	assertBoolExactEqual(t, AnyBool(F, N), N, "AnyBool(false, null)")
+	assertBoolExactEqual(t, AnyBool(N, T, F), T, "AnyBool(null, true, false)")
+}
+
+func assertBoolExactEqual(t *testing.T, got, want Bool, from string) {
+	t.Helper()
+	if !got.Equal(want) {
+		t.Errorf("bad %s: got %s, want %s", from, boolString(got), boolString(want))
+	}
+}
+
+func boolString(b Bool) string {
+	data, _ := b.MarshalJSON()
+	return string(data)
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
@@ -2,6 +2,7 @@ package null
 
 import (
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/guregu/null/v6/internal"
@@ -53,6 +54,16 @@ func (b Byte) ValueOr(v byte) byte {
 	return b.Byte
 }
 
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range instead of truncating it.
+func (b *Byte) Scan(src any) error {
+	if err := internal.ScanInt(src, &b.Byte, &b.Valid); err != nil {
+		return fmt.Errorf("null: couldn't scan Byte: %w", err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Byte.
@@ -70,19 +81,31 @@ func (b *Byte) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Byte is null.
 func (b Byte) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Byte to dst, as MarshalJSON does.
+// It will append null if this Byte is null.
+func (b Byte) AppendJSON(dst []byte) ([]byte, error) {
 	if !b.Valid {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
 	}
-	return []byte(strconv.FormatInt(int64(b.Byte), 10)), nil
+	return strconv.AppendInt(dst, int64(b.Byte), 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Byte is null.
 func (b Byte) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Byte is null.
+func (b Byte) AppendText(dst []byte) ([]byte, error) {
 	if !b.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return []byte(strconv.FormatInt(int64(b.Byte), 10)), nil
+	return strconv.AppendInt(dst, int64(b.Byte), 10), nil
 }
 
 // SetValid changes this Byte's value and also sets it to be non-null.
@@ -110,6 +133,12 @@ func (b Byte) Equal(other Byte) bool {
 	return b.Valid == other.Valid && (!b.Valid || b.Byte == other.Byte)
 }
 
+// Compare returns an integer comparing two Bytes, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (b Byte) Compare(other Byte) int {
+	return internal.Compare(b.Byte, b.Valid, other.Byte, other.Valid)
+}
+
 func (b Byte) value() (int64, bool) {
 	return int64(b.Byte), b.Valid
 }
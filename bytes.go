@@ -0,0 +1,224 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Bytes is a nullable []byte, such as a BYTEA or BLOB column.
+// It does not consider empty slices to be null.
+// It will marshal to null if null, and to a base64 string otherwise.
+type Bytes struct {
+	Bytes []byte
+	Valid bool // Valid is true if Bytes is not NULL
+}
+
+// NewBytes creates a new Bytes.
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// BytesFrom creates a new Bytes that will always be valid.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, true)
+}
+
+// BytesFromPtr creates a new Bytes that will be null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return NewBytes(*b, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (b Bytes) ValueOr(v []byte) []byte {
+	if !b.Valid {
+		return v
+	}
+	return b.Bytes
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts []byte and string values.
+// The data is copied, because drivers may reuse their buffers.
+func (b *Bytes) Scan(src any) error {
+	switch x := src.(type) {
+	case nil:
+		b.Bytes, b.Valid = nil, false
+		return nil
+	case []byte:
+		b.Bytes, b.Valid = bytes.Clone(x), true
+		if b.Bytes == nil {
+			b.Bytes = []byte{}
+		}
+		return nil
+	case string:
+		b.Bytes, b.Valid = []byte(x), true
+		return nil
+	}
+	b.Bytes, b.Valid = nil, false
+	return fmt.Errorf("null: couldn't scan Bytes: unsupported type %T", src)
+}
+
+// Value implements the driver Valuer interface.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	if b.Bytes == nil {
+		// a nil []byte would be sent as NULL
+		return []byte{}, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64 string and null input.
+// An empty string will not be considered a null Bytes.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	var v []byte
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if v == nil {
+		v = []byte{}
+	}
+	b.Bytes, b.Valid = v, true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It decodes base64 text, and will unmarshal to a null Bytes if the input is blank.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	v, err := internal.DecodeBytes(text, internal.BytesBase64)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	b.Bytes, b.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bytes is null, otherwise a base64 string.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Bytes to dst, as MarshalJSON does.
+// It will append null if this Bytes is null.
+func (b Bytes) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '"')
+	dst, _ = internal.AppendBytes(dst, b.Bytes, internal.BytesBase64)
+	return append(dst, '"'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Bytes is null, otherwise a base64 string.
+func (b Bytes) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Bytes is null.
+func (b Bytes) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return dst, nil
+	}
+	return internal.AppendBytes(dst, b.Bytes, internal.BytesBase64)
+}
+
+// SetValid changes this Bytes's value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// IsZero returns true for null Bytes.
+// A non-null Bytes with an empty value will not be considered zero.
+func (b Bytes) IsZero() bool {
+	return !b.Valid
+}
+
+// Equal returns true if both Bytes have the same value or are both null.
+// Values are compared with bytes.Equal, so nil and empty slices are equal.
+func (b Bytes) Equal(other Bytes) bool {
+	return b.Valid == other.Valid && (!b.Valid || bytes.Equal(b.Bytes, other.Bytes))
+}
+
+// Compare returns an integer comparing two Bytes lexicographically, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (b Bytes) Compare(other Bytes) int {
+	return internal.CompareFunc(b.Bytes, b.Valid, other.Bytes, other.Valid, bytes.Compare)
+}
+
+// BytesHex is a Bytes that uses lowercase hexadecimal for text, instead of base64.
+// JSON still uses base64, like encoding/json does for []byte.
+// It is otherwise the same as Bytes, and can be made from one with BytesHex{b}.
+type BytesHex struct {
+	Bytes
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It decodes hex text, and will unmarshal to a null BytesHex if the input is blank.
+func (b *BytesHex) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		b.Bytes.Bytes, b.Valid = nil, false
+		return nil
+	}
+	v, err := internal.DecodeBytes(text, internal.BytesHex)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	b.Bytes.Bytes, b.Valid = v, true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this BytesHex is null, otherwise a hex string.
+func (b BytesHex) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this BytesHex is null.
+func (b BytesHex) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return dst, nil
+	}
+	return internal.AppendBytes(dst, b.Bytes.Bytes, internal.BytesHex)
+}
@@ -0,0 +1,198 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+var (
+	bytesValue = []byte{0xde, 0xad, 0xbe, 0xef}
+	bytesJSON  = []byte(`"3q2+7w=="`)
+)
+
+func TestBytesFrom(t *testing.T) {
+	assertBytes(t, BytesFrom(bytesValue), "BytesFrom()")
+	assertBytes(t, BytesFromPtr(&bytesValue), "BytesFromPtr()")
+	assertNullBytes(t, BytesFromPtr(nil), "BytesFromPtr(nil)")
+
+	empty := BytesFrom([]byte{})
+	if !empty.Valid || empty.IsZero() {
+		t.Error("empty Bytes should be valid")
+	}
+}
+
+func TestBytesScanValue(t *testing.T) {
+	src := bytes.Clone(bytesValue)
+	var b Bytes
+	err := b.Scan(src)
+	maybePanic(err)
+	// drivers may reuse their buffers
+	src[0] = 0
+	assertBytes(t, b, "scanned []byte")
+
+	err = b.Scan(string(bytesValue))
+	maybePanic(err)
+	assertBytes(t, b, "scanned string")
+
+	var empty Bytes
+	err = empty.Scan([]byte{})
+	maybePanic(err)
+	if !empty.Valid || empty.Bytes == nil {
+		t.Errorf("scanning empty []byte should be valid and non-nil, got %#v", empty)
+	}
+
+	var null Bytes
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBytes(t, null, "scanned null")
+
+	var bad Bytes
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error: scanning int64")
+	}
+
+	v, err := BytesFrom(bytesValue).Value()
+	maybePanic(err)
+	if got, ok := v.([]byte); !ok || !bytes.Equal(got, bytesValue) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = BytesFrom(nil).Value()
+	maybePanic(err)
+	if got, ok := v.([]byte); !ok || got == nil {
+		t.Errorf("valid nil Value() should be an empty slice, got %#v", v)
+	}
+	v, err = Bytes{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null Value() should be nil, got %#v", v)
+	}
+}
+
+func TestMarshalBytes(t *testing.T) {
+	data, err := json.Marshal(BytesFrom(bytesValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(bytesJSON), "non-empty json marshal")
+	// same as encoding/json
+	std, _ := json.Marshal(bytesValue)
+	assertJSONEquals(t, data, string(std), "encoding/json marshal")
+
+	data, err = json.Marshal(Bytes{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = json.Marshal(BytesFrom(nil))
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "empty json marshal")
+
+	var b Bytes
+	err = json.Unmarshal(bytesJSON, &b)
+	maybePanic(err)
+	assertBytes(t, b, "unmarshal json")
+
+	err = json.Unmarshal([]byte(`""`), &b)
+	maybePanic(err)
+	if !b.Valid || len(b.Bytes) != 0 {
+		t.Errorf(`"" should unmarshal to valid empty Bytes, got %#v`, b)
+	}
+
+	err = json.Unmarshal(nullJSON, &b)
+	maybePanic(err)
+	assertNullBytes(t, b, "unmarshal null json")
+
+	if err := json.Unmarshal([]byte(`"!!"`), &b); err == nil {
+		t.Error("expected error: bad base64")
+	}
+}
+
+func TestBytesText(t *testing.T) {
+	data, err := BytesFrom(bytesValue).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "3q2+7w==", "text marshal")
+
+	var b Bytes
+	err = b.UnmarshalText([]byte("3q2+7w=="))
+	maybePanic(err)
+	assertBytes(t, b, "unmarshal text")
+
+	data, err = Bytes{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var blank Bytes
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBytes(t, blank, "blank text")
+
+	var bad Bytes
+	if err := bad.UnmarshalText([]byte("!!")); err == nil {
+		t.Error("expected error: bad base64")
+	}
+}
+
+func TestBytesHex(t *testing.T) {
+	hb := BytesHex{BytesFrom(bytesValue)}
+	data, err := hb.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "deadbeef", "hex text marshal")
+	data, err = json.Marshal(hb)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"3q2+7w=="`, "hex json marshal")
+
+	type hexRecord struct {
+		B BytesHex `xml:"b"`
+	}
+	var v hexRecord
+	err = xml.Unmarshal([]byte(`<v><b>deadbeef</b></v>`), &v)
+	maybePanic(err)
+	assertBytes(t, v.B.Bytes, "unmarshal hex xml")
+	data, err = xml.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, `<hexRecord><b>deadbeef</b></hexRecord>`, "hex xml marshal")
+
+	data, err = BytesHex{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null hex text marshal")
+
+	var blank BytesHex
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullBytes(t, blank.Bytes, "blank hex text")
+
+	var bad BytesHex
+	if err := bad.UnmarshalText([]byte("xyz")); err == nil {
+		t.Error("expected error: bad hex")
+	}
+}
+
+func TestBytesEqual(t *testing.T) {
+	if !BytesFrom(bytesValue).Equal(BytesFrom(bytes.Clone(bytesValue))) {
+		t.Error("same bytes should be equal")
+	}
+	if !BytesFrom(nil).Equal(BytesFrom([]byte{})) {
+		t.Error("nil and empty should be equal")
+	}
+	if BytesFrom(nil).Equal(Bytes{}) {
+		t.Error("empty should not equal null")
+	}
+	assertCompare(t, BytesFrom([]byte("a")).Compare(BytesFrom([]byte("b"))), -1, "a vs b")
+	assertCompare(t, Bytes{}.Compare(BytesFrom(nil)), -1, "null vs empty")
+}
+
+func assertBytes(t *testing.T, b Bytes, from string) {
+	t.Helper()
+	if !bytes.Equal(b.Bytes, bytesValue) {
+		t.Errorf("bad %v bytes: %x ≠ %x", from, b.Bytes, bytesValue)
+	}
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullBytes(t *testing.T, b Bytes, from string) {
+	t.Helper()
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
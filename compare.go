@@ -0,0 +1,48 @@
+package null
+
+// Nulls specifies where null values are placed when sorting.
+type Nulls int
+
+const (
+	// NullsFirst places nulls before any non-null value, like ORDER BY ... NULLS FIRST.
+	NullsFirst Nulls = iota
+	// NullsLast places nulls after any non-null value, like ORDER BY ... NULLS LAST.
+	NullsLast
+)
+
+// comparer is satisfied by the types in this package and the zero package.
+type comparer[N any] interface {
+	Compare(N) int
+	IsZero() bool
+}
+
+// Compare returns an integer comparing a and b, following the conventions of cmp.Compare,
+// with null values placed according to nulls.
+// Non-null values are compared with their Compare method.
+// Types from the zero package consider zero values to be null.
+//
+// For descending order, swap the arguments:
+// Compare(b, a, NullsFirst) is equivalent to ORDER BY ... DESC NULLS LAST.
+func Compare[N comparer[N]](a, b N, nulls Nulls) int {
+	aNull, bNull := a.IsZero(), b.IsZero()
+	switch {
+	case aNull && bNull:
+		return 0
+	case aNull != bNull:
+		if aNull == (nulls == NullsFirst) {
+			return -1
+		}
+		return 1
+	}
+	return a.Compare(b)
+}
+
+// CompareFunc returns a comparison function suitable for slices.SortFunc
+// that places null values according to nulls.
+//
+//	slices.SortFunc(ages, null.CompareFunc[null.Int](null.NullsLast))
+func CompareFunc[N comparer[N]](nulls Nulls) func(a, b N) int {
+	return func(a, b N) int {
+		return Compare(a, b, nulls)
+	}
+}
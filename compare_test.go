@@ -0,0 +1,102 @@
+package null
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/guregu/null/v6/zero"
+)
+
+func TestCompareMethods(t *testing.T) {
+	assertCompare(t, IntFrom(1).Compare(IntFrom(2)), -1, "1 vs 2")
+	assertCompare(t, IntFrom(2).Compare(IntFrom(2)), 0, "2 vs 2")
+	assertCompare(t, Int{}.Compare(IntFrom(-5)), -1, "null vs -5")
+	assertCompare(t, IntFrom(-5).Compare(Int{}), 1, "-5 vs null")
+	assertCompare(t, Int{}.Compare(Int{}), 0, "null vs null")
+
+	assertCompare(t, BoolFrom(false).Compare(BoolFrom(true)), -1, "false vs true")
+	assertCompare(t, Bool{}.Compare(BoolFrom(false)), -1, "null vs false")
+
+	assertCompare(t, FloatFrom(math.NaN()).Compare(FloatFrom(math.Inf(-1))), -1, "NaN vs -Inf")
+	assertCompare(t, Float{}.Compare(FloatFrom(math.NaN())), -1, "null vs NaN")
+
+	assertCompare(t, StringFrom("b").Compare(StringFrom("a")), 1, "b vs a")
+	assertCompare(t, String{}.Compare(StringFrom("")), -1, "null vs blank")
+
+	early := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	same := early.In(time.FixedZone("JST", 9*60*60))
+	assertCompare(t, TimeFrom(early).Compare(TimeFrom(same)), 0, "same instant")
+	assertCompare(t, TimeFrom(early).Compare(TimeFrom(early.Add(time.Second))), -1, "early vs late")
+	assertCompare(t, Time{}.Compare(TimeFrom(time.Time{})), -1, "null vs zero time")
+
+	assertCompare(t, UintFrom(math.MaxUint64).Compare(UintFrom(0)), 1, "max vs 0")
+}
+
+func TestCompareGeneric(t *testing.T) {
+	type celsius float64
+	assertCompare(t, CompareValue(ValueFrom(1), ValueFrom(2)), -1, "Value 1 vs 2")
+	assertCompare(t, CompareValue(Value[int]{}, ValueFrom(-1)), -1, "Value null vs -1")
+	assertCompare(t, CompareValue(ValueFrom("b"), ValueFrom("a")), 1, "Value b vs a")
+	assertCompare(t, CompareValue(ValueFrom(celsius(1.5)), ValueFrom(celsius(1.5))), 0, "Value named float")
+	early := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assertCompare(t, ValueFrom(early).CompareFunc(ValueFrom(early.Add(time.Hour)), time.Time.Compare), -1, "Value times")
+	assertCompare(t, ValueFrom(IntFrom(1)).CompareFunc(ValueFrom(Int{}), Int.Compare), 1, "Value of null.Int")
+
+	// structs, the usual contents of a JSONValue, are compared with a function
+	type point struct{ X, Y int }
+	byX := func(a, b point) int { return cmp.Compare(a.X, b.X) }
+	assertCompare(t, JSONValueFrom(point{2, 0}).CompareFunc(JSONValueFrom(point{1, 5}), byX), 1, "JSONValue 2 vs 1")
+	assertCompare(t, JSONValue[point]{}.CompareFunc(JSONValueFrom(point{}), byX), -1, "JSONValue null vs zero")
+	assertCompare(t, ValueFrom(point{1, 0}).CompareFunc(ValueFrom(point{1, 9}), byX), 0, "Value struct")
+
+	assertCompare(t, Optional[int]{}.CompareFunc(OptionalNull[int](), cmp.Compare[int]), -1, "Optional unset vs null")
+	assertCompare(t, OptionalNull[int]().CompareFunc(OptionalFrom(-1), cmp.Compare[int]), -1, "Optional null vs -1")
+	assertCompare(t, OptionalFrom(2).CompareFunc(OptionalFrom(1), cmp.Compare[int]), 1, "Optional 2 vs 1")
+	assertCompare(t, OptionalNull[int]().CompareFunc(OptionalNull[int](), cmp.Compare[int]), 0, "Optional null vs null")
+
+	assertCompare(t, JSONFrom([]byte(`[1]`)).Compare(JSONFrom([]byte(`[2]`))), -1, "JSON bytes")
+	assertCompare(t, JSON{}.Compare(JSONFrom([]byte(`null`))), -1, "JSON null vs literal null")
+}
+
+func TestCompareNulls(t *testing.T) {
+	ints := []Int{IntFrom(3), {}, IntFrom(1), {}, IntFrom(2)}
+
+	slices.SortFunc(ints, CompareFunc[Int](NullsFirst))
+	want := []Int{{}, {}, IntFrom(1), IntFrom(2), IntFrom(3)}
+	if !slices.EqualFunc(ints, want, Int.Equal) {
+		t.Errorf("NullsFirst: %v ≠ %v", ints, want)
+	}
+
+	slices.SortFunc(ints, CompareFunc[Int](NullsLast))
+	want = []Int{IntFrom(1), IntFrom(2), IntFrom(3), {}, {}}
+	if !slices.EqualFunc(ints, want, Int.Equal) {
+		t.Errorf("NullsLast: %v ≠ %v", ints, want)
+	}
+
+	// DESC NULLS LAST
+	slices.SortFunc(ints, func(a, b Int) int {
+		return Compare(b, a, NullsFirst)
+	})
+	want = []Int{IntFrom(3), IntFrom(2), IntFrom(1), {}, {}}
+	if !slices.EqualFunc(ints, want, Int.Equal) {
+		t.Errorf("DESC NULLS LAST: %v ≠ %v", ints, want)
+	}
+
+	// zero types consider zero to be null
+	zeros := []zero.Int{zero.IntFrom(2), zero.NewInt(0, true), zero.IntFrom(-1), {}}
+	slices.SortFunc(zeros, CompareFunc[zero.Int](NullsLast))
+	zwant := []zero.Int{zero.IntFrom(-1), zero.IntFrom(2), {}, {}}
+	if !slices.EqualFunc(zeros, zwant, zero.Int.Equal) {
+		t.Errorf("zero NullsLast: %v ≠ %v", zeros, zwant)
+	}
+}
+
+func assertCompare(t *testing.T, got, want int, from string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("bad Compare (%s): %d ≠ %d", from, got, want)
+	}
+}
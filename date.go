@@ -0,0 +1,248 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Date is a nullable calendar date, such as a SQL DATE.
+// It has no time of day or location, so time zones can't change which day it represents.
+// It will marshal to null if null, and to a "2006-01-02" string otherwise.
+type Date struct {
+	Year  int        // Year, such as 2024.
+	Month time.Month // Month of the year, January = 1.
+	Day   int        // Day of the month, starting at 1.
+	Valid bool       // Valid is true if Date is not NULL
+}
+
+// NewDate creates a new Date.
+func NewDate(year int, month time.Month, day int, valid bool) Date {
+	return Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+		Valid: valid,
+	}
+}
+
+// DateFrom creates a new Date that will always be valid.
+func DateFrom(year int, month time.Month, day int) Date {
+	return NewDate(year, month, day, true)
+}
+
+// DateOf returns the date of t, in t's location. It will always be valid.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return DateFrom(year, month, day)
+}
+
+// DateFromPtr creates a new Date from the date of t, in t's location.
+// It will be null if t is nil.
+func DateFromPtr(t *time.Time) Date {
+	if t == nil {
+		return Date{}
+	}
+	return DateOf(*t)
+}
+
+// ParseDate parses a date in the format "2006-01-02".
+// It returns a null Date if s is blank.
+func ParseDate(s string) (Date, error) {
+	var d Date
+	err := d.UnmarshalText([]byte(s))
+	return d, err
+}
+
+// ValueOrZero returns midnight UTC at the start of this Date if valid, otherwise the zero time.
+func (d Date) ValueOrZero() time.Time {
+	if !d.Valid {
+		return time.Time{}
+	}
+	return d.In(time.UTC)
+}
+
+// ValueOr returns midnight UTC at the start of this Date if valid, otherwise v.
+func (d Date) ValueOr(v time.Time) time.Time {
+	if !d.Valid {
+		return v
+	}
+	return d.In(time.UTC)
+}
+
+// In returns midnight in loc at the start of this Date.
+// It returns the zero time if this Date is null.
+func (d Date) In(loc *time.Location) time.Time {
+	if !d.Valid {
+		return time.Time{}
+	}
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts time.Time values, using the date in the time's location,
+// as well as "2006-01-02" strings, optionally followed by a time.
+func (d *Date) Scan(src any) error {
+	if src == nil {
+		*d = Date{}
+		return nil
+	}
+	year, month, day, err := internal.ScanDate(src)
+	if err != nil {
+		*d = Date{}
+		return fmt.Errorf("null: couldn't scan Date: %w", err)
+	}
+	*d = DateFrom(year, month, day)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns midnight UTC at the start of this Date.
+func (d Date) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.In(time.UTC), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Date is null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return d.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Date to dst, as MarshalJSON does.
+// It will append null if this Date is null.
+func (d Date) AppendJSON(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '"')
+	dst = internal.AppendDate(dst, d.Year, d.Month, d.Day)
+	return append(dst, '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		*d = Date{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	year, month, day, err := internal.ParseDate(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	*d = DateFrom(year, month, day)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Date is null.
+func (d Date) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Date is null.
+func (d Date) AppendText(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return dst, nil
+	}
+	return internal.AppendDate(dst, d.Year, d.Month, d.Day), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Date if the input is blank or "null".
+func (d *Date) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		*d = Date{}
+		return nil
+	}
+	year, month, day, err := internal.ParseDate(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	*d = DateFrom(year, month, day)
+	return nil
+}
+
+// SetValid changes this Date to the date of t, in t's location, and sets it to be non-null.
+func (d *Date) SetValid(t time.Time) {
+	*d = DateOf(t)
+}
+
+// Ptr returns a pointer to midnight UTC at the start of this Date,
+// or a nil pointer if this Date is null.
+func (d Date) Ptr() *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	t := d.In(time.UTC)
+	return &t
+}
+
+// IsZero returns true for null Dates.
+// A non-null Date with a zero value will not be considered zero.
+func (d Date) IsZero() bool {
+	return !d.Valid
+}
+
+// Equal returns true if both Dates represent the same day or are both null.
+func (d Date) Equal(other Date) bool {
+	return d.Valid == other.Valid && (!d.Valid || d.Compare(other) == 0)
+}
+
+// Compare returns an integer comparing two Dates, following the conventions of cmp.Compare.
+// Null is less than any non-null Date.
+func (d Date) Compare(other Date) int {
+	return internal.CompareFunc(d, d.Valid, other, other.Valid, compareDate)
+}
+
+// Before reports whether d is before other. It returns false if either is null.
+func (d Date) Before(other Date) bool {
+	return d.Valid && other.Valid && compareDate(d, other) < 0
+}
+
+// After reports whether d is after other. It returns false if either is null.
+func (d Date) After(other Date) bool {
+	return d.Valid && other.Valid && compareDate(d, other) > 0
+}
+
+// AddDays returns the date n days after d. If d is null, the result is null.
+func (d Date) AddDays(n int) Date {
+	return d.AddDate(0, 0, n)
+}
+
+// AddDate returns the date corresponding to adding the given number of years, months, and days to d,
+// normalized in the same way as time.Time's AddDate. If d is null, the result is null.
+func (d Date) AddDate(years int, months int, days int) Date {
+	if !d.Valid {
+		return d
+	}
+	return DateOf(d.In(time.UTC).AddDate(years, months, days))
+}
+
+// DaysSince returns the number of days from other to d.
+// If either Date is null, the result is null.
+func (d Date) DaysSince(other Date) Int {
+	if !d.Valid || !other.Valid {
+		return Int{}
+	}
+	// days are always 86400 seconds long in UTC
+	const secondsPerDay = 24 * 60 * 60
+	return IntFrom((d.In(time.UTC).Unix() - other.In(time.UTC).Unix()) / secondsPerDay)
+}
+
+func compareDate(a, b Date) int {
+	return internal.CompareDate(a.Year, a.Month, a.Day, b.Year, b.Month, b.Day)
+}
@@ -0,0 +1,232 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	dateString = "2024-05-01"
+	dateJSON   = []byte(`"` + dateString + `"`)
+	dateValue  = DateFrom(2024, time.May, 1)
+)
+
+func TestDateFrom(t *testing.T) {
+	assertDate(t, dateValue, "DateFrom()")
+
+	// the day is taken from the time's own location
+	tokyo := time.FixedZone("JST", 9*60*60)
+	assertDate(t, DateOf(time.Date(2024, time.May, 1, 8, 0, 0, 0, tokyo)), "DateOf() tokyo")
+
+	midnight := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	assertDate(t, DateFromPtr(&midnight), "DateFromPtr()")
+	assertNullDate(t, DateFromPtr(nil), "DateFromPtr(nil)")
+
+	zero := DateOf(time.Time{})
+	if !zero.Valid {
+		t.Error("DateOf(time.Time{}) should be valid")
+	}
+}
+
+func TestUnmarshalDateJSON(t *testing.T) {
+	var d Date
+	err := json.Unmarshal(dateJSON, &d)
+	maybePanic(err)
+	assertDate(t, d, "UnmarshalJSON() json")
+
+	var null Date
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDate(t, null, "null json")
+
+	var timestamp Date
+	err = json.Unmarshal([]byte(`"2024-05-01T00:00:00Z"`), &timestamp)
+	if err == nil {
+		t.Error("expected error: timestamp")
+	}
+
+	var badDay Date
+	err = json.Unmarshal([]byte(`"2024-02-30"`), &badDay)
+	if err == nil {
+		t.Error("expected error: bad day")
+	}
+
+	var invalid Date
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullDate(t, invalid, "invalid json")
+
+	var wrongType Date
+	err = json.Unmarshal(intJSON, &wrongType)
+	if err == nil {
+		t.Error("expected error: wrong type JSON")
+	}
+	assertNullDate(t, wrongType, "wrong type json")
+}
+
+func TestUnmarshalDateText(t *testing.T) {
+	var d Date
+	err := d.UnmarshalText([]byte(dateString))
+	maybePanic(err)
+	assertDate(t, d, "UnmarshalText()")
+
+	var blank Date
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDate(t, blank, "blank text")
+
+	parsed, err := ParseDate(dateString)
+	maybePanic(err)
+	assertDate(t, parsed, "ParseDate()")
+
+	_, err = ParseDate("May 1, 2024")
+	if err == nil {
+		t.Error("expected error: bad format")
+	}
+}
+
+func TestMarshalDate(t *testing.T) {
+	data, err := json.Marshal(dateValue)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(dateJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(Date{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = dateValue.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, dateString, "non-empty text marshal")
+
+	data, err = Date{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	data, err = json.Marshal(DateFrom(12345, time.January, 1))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"12345-01-01"`, "large year json marshal")
+}
+
+func TestDateScanValue(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	for _, src := range []any{
+		time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.May, 1, 8, 0, 0, 0, tokyo),
+		dateString,
+		[]byte(dateString),
+		"2024-05-01 00:00:00",
+		"2024-05-01T12:34:56+09:00",
+	} {
+		var d Date
+		err := d.Scan(src)
+		maybePanic(err)
+		assertDate(t, d, "scanned date")
+	}
+
+	var null Date
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullDate(t, null, "scanned null")
+
+	var bad Date
+	if err := bad.Scan(int64(20240501)); err == nil {
+		t.Error("expected error: scanning int64")
+	}
+	assertNullDate(t, bad, "scanned int64")
+
+	v, err := dateValue.Value()
+	maybePanic(err)
+	if want := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC); v != want {
+		t.Errorf("bad Value(): %v ≠ %v", v, want)
+	}
+	v, err = Date{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null Value() should be nil, got %v", v)
+	}
+}
+
+func TestDateArithmetic(t *testing.T) {
+	assertDateEqual(t, dateValue.AddDays(30), DateFrom(2024, time.May, 31), "AddDays(30)")
+	assertDateEqual(t, dateValue.AddDays(-1), DateFrom(2024, time.April, 30), "AddDays(-1)")
+	assertDateEqual(t, DateFrom(2024, time.February, 28).AddDays(1), DateFrom(2024, time.February, 29), "leap day")
+	assertDateEqual(t, DateFrom(2024, time.January, 31).AddDate(0, 1, 0), DateFrom(2024, time.March, 2), "AddDate(0, 1, 0)")
+	assertDateEqual(t, Date{}.AddDays(1), Date{}, "null AddDays")
+
+	if days := DateFrom(2025, time.May, 1).DaysSince(dateValue); !days.Equal(IntFrom(365)) {
+		t.Errorf("bad DaysSince: %v", days)
+	}
+	if days := DateFrom(1, time.January, 1).DaysSince(DateFrom(9999, time.December, 31)); !days.Equal(IntFrom(-3652058)) {
+		t.Errorf("bad DaysSince across years: %v", days)
+	}
+	if days := dateValue.DaysSince(Date{}); days.Valid {
+		t.Errorf("DaysSince null should be null, got %v", days)
+	}
+}
+
+func TestDateCompare(t *testing.T) {
+	next := dateValue.AddDays(1)
+	assertCompare(t, dateValue.Compare(next), -1, "date vs next")
+	assertCompare(t, next.Compare(dateValue), 1, "next vs date")
+	assertCompare(t, dateValue.Compare(DateOf(dateValue.ValueOrZero())), 0, "same date")
+	assertCompare(t, Date{}.Compare(DateFrom(1, time.January, 1)), -1, "null vs zero date")
+
+	if !dateValue.Before(next) || next.Before(dateValue) {
+		t.Error("bad Before")
+	}
+	if !next.After(dateValue) || dateValue.After(next) {
+		t.Error("bad After")
+	}
+	if dateValue.Before(Date{}) || dateValue.After(Date{}) {
+		t.Error("comparisons with null should be false")
+	}
+	if !(Date{}).Equal(NewDate(2024, time.May, 1, false)) {
+		t.Error("nulls should be equal")
+	}
+	if dateValue.Equal(next) {
+		t.Error("different dates should not be equal")
+	}
+}
+
+func TestDatePointer(t *testing.T) {
+	ptr := dateValue.Ptr()
+	if want := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC); *ptr != want {
+		t.Errorf("bad Ptr(): %v ≠ %v", *ptr, want)
+	}
+	if ptr := (Date{}).Ptr(); ptr != nil {
+		t.Errorf("null Ptr() should be nil, got %v", ptr)
+	}
+
+	var d Date
+	d.SetValid(time.Date(2024, time.May, 1, 23, 59, 0, 0, time.UTC))
+	assertDate(t, d, "SetValid()")
+}
+
+func assertDate(t *testing.T, d Date, from string) {
+	t.Helper()
+	if d.Year != 2024 || d.Month != time.May || d.Day != 1 {
+		t.Errorf("bad %v date: %d-%d-%d ≠ %s", from, d.Year, d.Month, d.Day, dateString)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDate(t *testing.T, d Date, from string) {
+	t.Helper()
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertDateEqual(t *testing.T, got, want Date, from string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("bad %s: %#v ≠ %#v", from, got, want)
+	}
+}
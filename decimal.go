@@ -0,0 +1,586 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+var errDecimalSyntax = errors.New("invalid decimal syntax")
+
+// Decimal is a nullable arbitrary-precision decimal number, such as a SQL NUMERIC or DECIMAL.
+// Its value is an unscaled integer multiplied by 10^-scale, so values like 0.1 are represented exactly,
+// and the scale (the number of digits after the decimal point) is preserved.
+// Decimals are immutable: arithmetic methods return new values.
+// Unlike other types, the value is unexported to keep it that way; read it with Unscaled and Scale,
+// or as a *big.Rat with ValueOrZero, ValueOr, and Ptr.
+// The zero value is null.
+// It will marshal to null if null, and to a JSON number otherwise. Use DecimalString to marshal a string instead.
+type Decimal struct {
+	unscaled *big.Int // never modified after construction; nil means 0
+	scale    int32    // never negative
+	Valid    bool     // Valid is true if Decimal is not NULL
+}
+
+// NewDecimal creates a new Decimal with the value unscaled × 10^-scale.
+// unscaled is copied. A nil unscaled is treated as zero.
+// Like a Postgres NUMERIC, a Decimal has at most 131072 digits after the decimal point:
+// larger scales are rounded half away from zero to that many places.
+// A nonzero value with a scale below -131072 is out of range, and the result is null.
+func NewDecimal(unscaled *big.Int, scale int32, valid bool) Decimal {
+	var coef *big.Int
+	if unscaled != nil {
+		coef = new(big.Int).Set(unscaled)
+	}
+	return newDecimal(coef, int64(scale), valid)
+}
+
+// DecimalFrom creates a new Decimal with the value unscaled × 10^-scale that will always be valid.
+// unscaled is copied. A nil unscaled is treated as zero.
+func DecimalFrom(unscaled *big.Int, scale int32) Decimal {
+	return NewDecimal(unscaled, scale, true)
+}
+
+// DecimalFromInt creates a new Decimal with the integer value i that will always be valid.
+func DecimalFromInt(i int64) Decimal {
+	return newDecimal(big.NewInt(i), 0, true)
+}
+
+// DecimalFromPtr creates a new Decimal from r that will be null if r is nil.
+// r is converted as described in SetValid. To parse a decimal string, use ParseDecimal.
+func DecimalFromPtr(r *big.Rat) Decimal {
+	if r == nil {
+		return Decimal{}
+	}
+	return decimalFromRat(r)
+}
+
+// ParseDecimal parses a decimal number such as "-12.50" or "1.5e3".
+// The scale of the result is the number of digits after the decimal point, adjusted by the exponent.
+// It returns a null Decimal if s is blank.
+func ParseDecimal(s string) (Decimal, error) {
+	var d Decimal
+	err := d.UnmarshalText([]byte(s))
+	return d, err
+}
+
+// newDecimal takes ownership of coef, which may be nil.
+// Negative scales are normalized to zero by multiplying out.
+// Scales are limited to ±internal.MaxDecimalDigits as described in NewDecimal,
+// so that pow10 can't be asked for a huge number.
+func newDecimal(coef *big.Int, scale int64, valid bool) Decimal {
+	if !valid {
+		return Decimal{}
+	}
+	if coef == nil {
+		coef = new(big.Int)
+	}
+	switch {
+	case coef.Sign() == 0:
+		scale = min(max(scale, 0), internal.MaxDecimalDigits)
+	case scale > internal.MaxDecimalDigits:
+		coef = shiftRound(coef, scale-internal.MaxDecimalDigits, roundHalfAwayFromZero)
+		scale = internal.MaxDecimalDigits
+	case scale < -internal.MaxDecimalDigits:
+		return Decimal{}
+	}
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{unscaled: coef, scale: int32(scale), Valid: true}
+}
+
+// ValueOrZero returns this Decimal's value as a new *big.Rat if valid, otherwise a new big.Rat equal to 0.
+func (d Decimal) ValueOrZero() *big.Rat {
+	if !d.Valid {
+		return new(big.Rat)
+	}
+	return d.Rat()
+}
+
+// ValueOr returns this Decimal's value as a new *big.Rat if valid, otherwise v.
+func (d Decimal) ValueOr(v *big.Rat) *big.Rat {
+	if !d.Valid {
+		return v
+	}
+	return d.Rat()
+}
+
+// Unscaled returns a copy of the unscaled value, or nil if this Decimal is null.
+func (d Decimal) Unscaled() *big.Int {
+	if !d.Valid {
+		return nil
+	}
+	return new(big.Int).Set(d.coef())
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Rat returns this Decimal's value as a *big.Rat, or nil if this Decimal is null.
+func (d Decimal) Rat() *big.Rat {
+	if !d.Valid {
+		return nil
+	}
+	return new(big.Rat).SetFrac(d.coef(), pow10(int64(d.scale)))
+}
+
+// Float returns the nearest float64 to this Decimal's value.
+// If this Decimal is null, the result is null.
+func (d Decimal) Float() Float {
+	if !d.Valid {
+		return Float{}
+	}
+	f, _ := d.Rat().Float64()
+	return FloatFrom(f)
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts decimal strings and []byte values, such as NUMERIC text, without loss of precision.
+// It also accepts int64, and float64 using its shortest exact representation.
+func (d *Decimal) Scan(src any) error {
+	var err error
+	switch x := src.(type) {
+	case nil:
+		*d = Decimal{}
+		return nil
+	case string:
+		*d, err = parseDecimal(x)
+	case []byte:
+		*d, err = parseDecimal(string(x))
+	case int64:
+		*d = DecimalFromInt(x)
+	case float64:
+		*d, err = parseDecimal(strconv.FormatFloat(x, 'g', -1, 64))
+	default:
+		err = fmt.Errorf("unsupported type %T", src)
+	}
+	if err != nil {
+		*d = Decimal{}
+		return fmt.Errorf("null: couldn't scan Decimal: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the decimal string, which databases convert to NUMERIC without loss of precision.
+func (d Decimal) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return string(d.appendText(nil)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		*d = Decimal{}
+		return nil
+	}
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := parseDecimal(num.String())
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	*d = v
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Decimal if the input is blank or "null".
+func (d *Decimal) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		*d = Decimal{}
+		return nil
+	}
+	v, err := parseDecimal(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Decimal is null, otherwise a number such as 12.50.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return d.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Decimal to dst, as MarshalJSON does.
+// It will append null if this Decimal is null.
+func (d Decimal) AppendJSON(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, "null"...), nil
+	}
+	return d.appendText(dst), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Decimal is null.
+// Valid Decimals are encoded without an exponent, keeping all digits of the scale, such as "-12.50".
+func (d Decimal) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Decimal is null.
+func (d Decimal) AppendText(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return dst, nil
+	}
+	return d.appendText(dst), nil
+}
+
+// SetValid changes this Decimal's value to r and also sets it to be non-null.
+// The scale is the smallest that represents r exactly, such as 1 for 3/2.
+// If r has no finite decimal expansion, such as 1/3, it is rounded half away from zero
+// to at least 16 decimal places. A nil r is treated as zero.
+func (d *Decimal) SetValid(r *big.Rat) {
+	if r == nil {
+		*d = DecimalFromInt(0)
+		return
+	}
+	*d = decimalFromRat(r)
+}
+
+// Ptr returns this Decimal's value as a new *big.Rat, or a nil pointer if this Decimal is null.
+func (d Decimal) Ptr() *big.Rat {
+	if !d.Valid {
+		return nil
+	}
+	return d.Rat()
+}
+
+// IsZero returns true for null Decimals.
+// A non-null Decimal with a zero value will not be considered zero.
+func (d Decimal) IsZero() bool {
+	return !d.Valid
+}
+
+// Equal returns true if both Decimals have the same numeric value or are both null.
+// Decimals with different scales can be equal, such as 1.5 and 1.50.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Valid == other.Valid && (!d.Valid || compareDecimal(d, other) == 0)
+}
+
+// Compare returns an integer comparing two Decimals numerically, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (d Decimal) Compare(other Decimal) int {
+	return internal.CompareFunc(d, d.Valid, other, other.Valid, compareDecimal)
+}
+
+// CmpNull compares d and other numerically, like SQL comparison operators,
+// returning -1, 0, or +1 if d is less than, equal to, or greater than other.
+// If either is null, the result is null. To sort Decimals, use Compare.
+func (d Decimal) CmpNull(other Decimal) Int {
+	if !d.Valid || !other.Valid {
+		return Int{}
+	}
+	return IntFrom(int64(compareDecimal(d, other)))
+}
+
+// Sign returns -1, 0, or +1 depending on the sign of d.
+// If d is null, the result is null.
+func (d Decimal) Sign() Int {
+	if !d.Valid {
+		return Int{}
+	}
+	return IntFrom(int64(d.coef().Sign()))
+}
+
+// Add returns d + other, with the larger of the two scales.
+// If either is null, the result is null.
+func (d Decimal) Add(other Decimal) Decimal {
+	if !d.Valid || !other.Valid {
+		return Decimal{}
+	}
+	a, b, scale := align(d, other)
+	return newDecimal(a.Add(a, b), scale, true)
+}
+
+// Sub returns d - other, with the larger of the two scales.
+// If either is null, the result is null.
+func (d Decimal) Sub(other Decimal) Decimal {
+	if !d.Valid || !other.Valid {
+		return Decimal{}
+	}
+	a, b, scale := align(d, other)
+	return newDecimal(a.Sub(a, b), scale, true)
+}
+
+// Mul returns d × other exactly, with the sum of the two scales.
+// If either is null, the result is null.
+func (d Decimal) Mul(other Decimal) Decimal {
+	if !d.Valid || !other.Valid {
+		return Decimal{}
+	}
+	return newDecimal(new(big.Int).Mul(d.coef(), other.coef()), int64(d.scale)+int64(other.scale), true)
+}
+
+// Neg returns -d. If d is null, the result is null.
+func (d Decimal) Neg() Decimal {
+	if !d.Valid {
+		return d
+	}
+	return newDecimal(new(big.Int).Neg(d.coef()), int64(d.scale), true)
+}
+
+// Abs returns the absolute value of d. If d is null, the result is null.
+func (d Decimal) Abs() Decimal {
+	if !d.Valid {
+		return d
+	}
+	return newDecimal(new(big.Int).Abs(d.coef()), int64(d.scale), true)
+}
+
+// Round returns d rounded to the given number of decimal places, with halves rounded away from zero,
+// like SQL's ROUND. The result has a scale of places, or 0 if places is negative,
+// in which case d is rounded to the left of the decimal point: Round(-2) of 1250 is 1300.
+// If d is null, the result is null.
+func (d Decimal) Round(places int32) Decimal {
+	return d.rescale(places, roundHalfAwayFromZero)
+}
+
+// Truncate returns d truncated toward zero to the given number of decimal places, like SQL's TRUNC.
+// If d is null, the result is null.
+func (d Decimal) Truncate(places int32) Decimal {
+	return d.rescale(places, roundTowardZero)
+}
+
+// Floor returns the greatest value with the given number of decimal places that is not greater than d.
+// If d is null, the result is null.
+func (d Decimal) Floor(places int32) Decimal {
+	return d.rescale(places, roundFloor)
+}
+
+// Ceil returns the least value with the given number of decimal places that is not less than d.
+// If d is null, the result is null.
+func (d Decimal) Ceil(places int32) Decimal {
+	return d.rescale(places, roundCeil)
+}
+
+type roundingMode int
+
+const (
+	roundHalfAwayFromZero roundingMode = iota
+	roundTowardZero
+	roundFloor
+	roundCeil
+)
+
+func (d Decimal) rescale(places int32, mode roundingMode) Decimal {
+	if !d.Valid {
+		return d
+	}
+	places = max(min(places, internal.MaxDecimalDigits), -internal.MaxDecimalDigits)
+	if places >= d.scale {
+		// adding digits never changes the value
+		coef := new(big.Int).Mul(d.coef(), pow10(int64(places)-int64(d.scale)))
+		return newDecimal(coef, int64(places), true)
+	}
+
+	shift := int64(d.scale) - int64(places)
+	q := shiftRound(d.coef(), shift, mode)
+	// a negative scale is multiplied out by newDecimal
+	return newDecimal(q, int64(d.scale)-shift, true)
+}
+
+// shiftRound returns coef / 10^shift, rounded with mode.
+func shiftRound(coef *big.Int, shift int64, mode roundingMode) *big.Int {
+	// once 10^shift is more than twice |coef|, which is less than 2^BitLen, larger shifts round the same way
+	shift = min(shift, int64(coef.BitLen()/3)+2)
+	return quoRound(coef, pow10(shift), mode)
+}
+
+// quoRound returns x / y, rounded with mode. y must be positive.
+func quoRound(x, y *big.Int, mode roundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() != 0 {
+		switch mode {
+		case roundHalfAwayFromZero:
+			if r.Abs(r).Lsh(r, 1).Cmp(y) >= 0 {
+				q.Add(q, big.NewInt(int64(x.Sign())))
+			}
+		case roundFloor:
+			if x.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+			}
+		case roundCeil:
+			if x.Sign() > 0 {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+	}
+	return q
+}
+
+func (d Decimal) coef() *big.Int {
+	if d.unscaled == nil {
+		return internal.BigZero
+	}
+	return d.unscaled
+}
+
+// appendText appends the coefficient's digits, then moves them over to make room for the decimal point,
+// so that it doesn't allocate when the coefficient fits in 64 bits.
+func (d Decimal) appendText(b []byte) []byte {
+	start := len(b)
+	b = internal.AppendBigInt(b, d.coef())
+	if b[start] == '-' {
+		start++
+	}
+	scale := int(d.scale)
+	if scale == 0 {
+		return b
+	}
+	if n := len(b) - start; n <= scale {
+		// 0.00ddd
+		pad := 2 + scale - n
+		b = append(b, make([]byte, pad)...)
+		copy(b[start+pad:], b[start:start+n])
+		b[start], b[start+1] = '0', '.'
+		for i := start + 2; i < start+pad; i++ {
+			b[i] = '0'
+		}
+		return b
+	}
+	at := len(b) - scale
+	b = append(b, 0)
+	copy(b[at+1:], b[at:])
+	b[at] = '.'
+	return b
+}
+
+func parseDecimal(s string) (Decimal, error) {
+	str := s
+	var exp int64
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("%w: %q", errDecimalSyntax, s)
+		}
+		str = str[:i]
+	}
+	neg := false
+	if len(str) > 0 && (str[0] == '-' || str[0] == '+') {
+		neg = str[0] == '-'
+		str = str[1:]
+	}
+	whole, frac, _ := strings.Cut(str, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return Decimal{}, fmt.Errorf("%w: %q", errDecimalSyntax, s)
+	}
+	scale := int64(len(frac)) - exp
+	if scale < -internal.MaxDecimalDigits || scale > internal.MaxDecimalDigits {
+		return Decimal{}, fmt.Errorf("decimal exponent out of range: %q", s)
+	}
+	coef, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", errDecimalSyntax, s)
+	}
+	if neg {
+		coef.Neg(coef)
+	}
+	return newDecimal(coef, scale, true), nil
+}
+
+// ratScale is the scale used for fractions without a finite decimal expansion.
+// It matches the minimum number of significant digits of Postgres NUMERIC division.
+const ratScale = 16
+
+func decimalFromRat(r *big.Rat) Decimal {
+	// r is in lowest terms, so it has a finite decimal expansion
+	// if its denominator only has the prime factors 2 and 5
+	den := r.Denom()
+	twos := int64(min(den.TrailingZeroBits(), internal.MaxDecimalDigits))
+	rest := new(big.Int).Rsh(den, uint(twos))
+	var fives int64
+	five, m := big.NewInt(5), new(big.Int)
+	for fives < internal.MaxDecimalDigits {
+		q, _ := new(big.Int).QuoRem(rest, five, m)
+		if m.Sign() != 0 {
+			break
+		}
+		rest = q
+		fives++
+	}
+	scale := max(twos, fives)
+	if rest.Cmp(big.NewInt(1)) != 0 {
+		scale = max(scale, ratScale)
+	}
+	scale = min(scale, internal.MaxDecimalDigits)
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	return newDecimal(quoRound(num, den, roundHalfAwayFromZero), scale, true)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// align returns copies of the unscaled values of a and b, scaled to their larger scale.
+func align(a, b Decimal) (*big.Int, *big.Int, int64) {
+	x, y := new(big.Int).Set(a.coef()), new(big.Int).Set(b.coef())
+	switch {
+	case a.scale < b.scale:
+		x.Mul(x, pow10(int64(b.scale)-int64(a.scale)))
+		return x, y, int64(b.scale)
+	case a.scale > b.scale:
+		y.Mul(y, pow10(int64(a.scale)-int64(b.scale)))
+	}
+	return x, y, int64(a.scale)
+}
+
+func compareDecimal(a, b Decimal) int {
+	x, y, _ := align(a, b)
+	return x.Cmp(y)
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+// DecimalString is a Decimal that marshals to a JSON string such as "12.50",
+// for clients that would otherwise decode numbers as float64.
+// It is otherwise the same as Decimal, and can be made from one with DecimalString{d}.
+// Like Decimal, it unmarshals from both numbers and strings.
+type DecimalString struct {
+	Decimal
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this DecimalString is null, otherwise a string such as "12.50".
+func (d DecimalString) MarshalJSON() ([]byte, error) {
+	return d.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this DecimalString to dst, as MarshalJSON does.
+// It will append null if this DecimalString is null.
+func (d DecimalString) AppendJSON(dst []byte) ([]byte, error) {
+	if !d.Valid {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '"')
+	dst = d.appendText(dst)
+	return append(dst, '"'), nil
+}
@@ -0,0 +1,418 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+)
+
+var (
+	decimalString = "12345678901234567890.0123456789"
+	decimalJSON   = []byte(decimalString)
+)
+
+func TestDecimalFrom(t *testing.T) {
+	unscaled, _ := new(big.Int).SetString("123456789012345678900123456789", 10)
+	assertDecimal(t, DecimalFrom(unscaled, 10), "DecimalFrom()")
+	assertNullDecimal(t, NewDecimal(unscaled, 10, false), "NewDecimal(false)")
+
+	d := DecimalFrom(unscaled, 10)
+	unscaled.SetInt64(1)
+	assertDecimal(t, d, "DecimalFrom() after modifying input")
+
+	rat, _ := new(big.Rat).SetString(decimalString)
+	assertDecimal(t, DecimalFromPtr(rat), "DecimalFromPtr()")
+	assertNullDecimal(t, DecimalFromPtr(nil), "DecimalFromPtr(nil)")
+
+	if zero := DecimalFrom(nil, 0); !zero.Valid || zero.Sign().ValueOrZero() != 0 {
+		t.Error("DecimalFrom(nil, 0) should be valid zero")
+	}
+	if got := DecimalFromInt(-42).Unscaled().Int64(); got != -42 {
+		t.Error("DecimalFromInt(-42) unscaled:", got)
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	table := []struct {
+		in  string
+		out string
+	}{
+		{"0", "0"},
+		{"-0.00", "0.00"},
+		{"+1.50", "1.50"},
+		{"-12.5", "-12.5"},
+		{".5", "0.5"},
+		{"5.", "5"},
+		{"0.000001", "0.000001"},
+		{"1e3", "1000"},
+		{"1.5E-3", "0.0015"},
+		{"-2.50e1", "-25.0"},
+		{decimalString, decimalString},
+	}
+	for _, tc := range table {
+		d, err := ParseDecimal(tc.in)
+		maybePanic(err)
+		if got, _ := d.MarshalText(); string(got) != tc.out {
+			t.Errorf("ParseDecimal(%q): got %q, want %q", tc.in, got, tc.out)
+		}
+	}
+
+	for _, s := range []string{"abc", "1.2.3", "-", ".", "1e", "1e1.5", "NaN", "Infinity", " 1", "1e999999999"} {
+		if _, err := ParseDecimal(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+
+	blank, err := ParseDecimal("")
+	maybePanic(err)
+	assertNullDecimal(t, blank, "blank")
+}
+
+func TestDecimalScanValue(t *testing.T) {
+	for _, src := range []any{decimalString, []byte(decimalString)} {
+		var d Decimal
+		err := d.Scan(src)
+		maybePanic(err)
+		assertDecimal(t, d, "scanned")
+		v, err := d.Value()
+		maybePanic(err)
+		if v != decimalString {
+			t.Errorf("bad value: %#v", v)
+		}
+	}
+
+	var i Decimal
+	maybePanic(i.Scan(int64(-7)))
+	if v, _ := i.Value(); v != "-7" {
+		t.Errorf("scanned int64: bad value: %#v", v)
+	}
+
+	var f Decimal
+	maybePanic(f.Scan(0.1))
+	if v, _ := f.Value(); v != "0.1" {
+		t.Errorf("scanned float64: bad value: %#v", v)
+	}
+
+	var null Decimal
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullDecimal(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Errorf("null value: %#v %v", v, err)
+	}
+
+	var bad Decimal
+	if err := bad.Scan("1.2.3"); err == nil {
+		t.Error("expected error: scanning bad decimal")
+	}
+	if err := bad.Scan(true); err == nil {
+		t.Error("expected error: scanning bool")
+	}
+	assertNullDecimal(t, bad, "bad scan")
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var d Decimal
+	err := json.Unmarshal(decimalJSON, &d)
+	maybePanic(err)
+	assertDecimal(t, d, "number json")
+
+	var str Decimal
+	err = json.Unmarshal([]byte(`"`+decimalString+`"`), &str)
+	maybePanic(err)
+	assertDecimal(t, str, "string json")
+
+	var null Decimal
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDecimal(t, null, "null json")
+
+	var bad Decimal
+	for _, data := range [][]byte{invalidJSON, boolJSON, []byte(`"abc"`), []byte(`""`)} {
+		if err := json.Unmarshal(data, &bad); err == nil {
+			t.Errorf("expected error unmarshaling %s", data)
+		}
+	}
+
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, decimalString, "number json marshal")
+	data, err = json.Marshal(Decimal{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = json.Marshal(DecimalString{d})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+decimalString+`"`, "string json marshal")
+	data, err = json.Marshal(DecimalString{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null string json marshal")
+
+	var ds DecimalString
+	err = json.Unmarshal([]byte(`"`+decimalString+`"`), &ds)
+	maybePanic(err)
+	assertDecimal(t, ds.Decimal, "string json unmarshal")
+}
+
+func TestDecimalText(t *testing.T) {
+	var d Decimal
+	err := d.UnmarshalText([]byte(decimalString))
+	maybePanic(err)
+	assertDecimal(t, d, "text")
+
+	text, err := Decimal{}.MarshalText()
+	maybePanic(err)
+	if string(text) != "" {
+		t.Errorf("null text: %q", text)
+	}
+
+	var null Decimal
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullDecimal(t, null, `"null" text`)
+
+	if err := null.UnmarshalText([]byte("1,5")); err == nil {
+		t.Error("expected error: bad text")
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, b := mustDecimal("1.10"), mustDecimal("-0.025")
+	table := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"Add", a.Add(b), "1.075"},
+		{"Sub", a.Sub(b), "1.125"},
+		{"Mul", a.Mul(b), "-0.02750"},
+		{"Neg", b.Neg(), "0.025"},
+		{"Abs", b.Abs(), "0.025"},
+		{"Add exact", mustDecimal("0.1").Add(mustDecimal("0.2")), "0.3"},
+	}
+	for _, tc := range table {
+		if got, _ := tc.got.MarshalText(); string(got) != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+
+	if got := a.Add(Decimal{}); got.Valid {
+		t.Error("Add(null) should be null")
+	}
+	if got := (Decimal{}).Sub(a); got.Valid {
+		t.Error("null.Sub() should be null")
+	}
+	if got := a.Mul(Decimal{}); got.Valid {
+		t.Error("Mul(null) should be null")
+	}
+	if got := (Decimal{}).Neg(); got.Valid {
+		t.Error("null.Neg() should be null")
+	}
+
+	// operands are not modified
+	if got, _ := a.MarshalText(); string(got) != "1.10" {
+		t.Error("Add modified receiver:", string(got))
+	}
+}
+
+func TestDecimalValueOrZero(t *testing.T) {
+	valid := mustDecimal("-1.50")
+	if v := valid.ValueOrZero(); v.Cmp(big.NewRat(-3, 2)) != 0 {
+		t.Error("unexpected ValueOrZero", v)
+	}
+	if v := (Decimal{}).ValueOrZero(); v.Sign() != 0 {
+		t.Error("unexpected ValueOrZero", v)
+	}
+	def := big.NewRat(1, 3)
+	if v := valid.ValueOr(def); v.Cmp(big.NewRat(-3, 2)) != 0 {
+		t.Error("unexpected ValueOr", v)
+	}
+	if v := (Decimal{}).ValueOr(def); v != def {
+		t.Error("unexpected ValueOr", v)
+	}
+	if p := valid.Ptr(); p == nil || p.Cmp(big.NewRat(-3, 2)) != 0 {
+		t.Error("bad Ptr", p)
+	}
+	if p := (Decimal{}).Ptr(); p != nil {
+		t.Error("bad Ptr for null", p)
+	}
+}
+
+func TestDecimalSetValid(t *testing.T) {
+	table := []struct {
+		in   *big.Rat
+		want string
+	}{
+		{big.NewRat(3, 2), "1.5"},
+		{big.NewRat(-1, 40), "-0.025"},
+		{big.NewRat(100, 1), "100"},
+		{big.NewRat(1, 3), "0.3333333333333333"},
+		{big.NewRat(-2, 3), "-0.6666666666666667"},
+		{big.NewRat(1, 6), "0.1666666666666667"},
+		{nil, "0"},
+	}
+	for _, tc := range table {
+		var d Decimal
+		d.SetValid(tc.in)
+		if got, _ := d.MarshalText(); !d.Valid || string(got) != tc.want {
+			t.Errorf("SetValid(%v): got %q, want %q", tc.in, got, tc.want)
+		}
+	}
+
+	// the scale is bounded
+	huge := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 500000))
+	if d := DecimalFromPtr(huge); d.Scale() != 131072 || d.Unscaled().Sign() != 0 {
+		t.Errorf("DecimalFromPtr(1/2^500000): got %v × 10^-%d", d.Unscaled(), d.Scale())
+	}
+}
+
+func TestDecimalScaleLimit(t *testing.T) {
+	const maxScale = 131072
+
+	if d := DecimalFrom(big.NewInt(1), math.MinInt32+1); d.Valid {
+		t.Error("DecimalFrom with a huge negative scale should be null")
+	}
+	if d := DecimalFrom(big.NewInt(0), math.MinInt32+1); !d.Valid || d.Scale() != 0 {
+		t.Errorf("zero with a huge negative scale should be valid zero: %v", d)
+	}
+
+	d := DecimalFrom(big.NewInt(15), maxScale+1)
+	if d.Scale() != maxScale || d.Unscaled().Int64() != 2 {
+		t.Errorf("DecimalFrom with a huge scale: got %v × 10^-%d, want 2 × 10^-%d", d.Unscaled(), d.Scale(), maxScale)
+	}
+	if d := DecimalFrom(big.NewInt(1), math.MaxInt32); d.Scale() != maxScale || d.Unscaled().Sign() != 0 {
+		t.Errorf("DecimalFrom with scale MaxInt32: got %v × 10^-%d", d.Unscaled(), d.Scale())
+	}
+
+	small := DecimalFrom(big.NewInt(5), maxScale)
+	if got := small.Mul(small); got.Scale() != maxScale || got.Unscaled().Sign() != 0 {
+		t.Errorf("Mul beyond the scale limit: got %v × 10^-%d", got.Unscaled(), got.Scale())
+	}
+	if got := small.Add(DecimalFromInt(1)).Scale(); got != maxScale {
+		t.Errorf("Add: bad scale %d", got)
+	}
+}
+
+func TestDecimalRound(t *testing.T) {
+	table := []struct {
+		in     string
+		places int32
+		round  string
+		trunc  string
+		floor  string
+		ceil   string
+	}{
+		{"1.25", 1, "1.3", "1.2", "1.2", "1.3"},
+		{"-1.25", 1, "-1.3", "-1.2", "-1.3", "-1.2"},
+		{"1.24", 1, "1.2", "1.2", "1.2", "1.3"},
+		{"1.2", 3, "1.200", "1.200", "1.200", "1.200"},
+		{"1250", -2, "1300", "1200", "1200", "1300"},
+		{"-1250.5", -2, "-1300", "-1200", "-1300", "-1200"},
+		{"0.5", 0, "1", "0", "0", "1"},
+		{"-0.4", 0, "0", "0", "-1", "0"},
+		{"99.5", 0, "100", "99", "99", "100"},
+		{"123", -5, "0", "0", "0", "100000"},
+	}
+	for _, tc := range table {
+		d := mustDecimal(tc.in)
+		for _, op := range []struct {
+			name string
+			got  Decimal
+			want string
+		}{
+			{"Round", d.Round(tc.places), tc.round},
+			{"Truncate", d.Truncate(tc.places), tc.trunc},
+			{"Floor", d.Floor(tc.places), tc.floor},
+			{"Ceil", d.Ceil(tc.places), tc.ceil},
+		} {
+			if got, _ := op.got.MarshalText(); string(got) != op.want {
+				t.Errorf("%s(%s, %d): got %q, want %q", op.name, tc.in, tc.places, got, op.want)
+			}
+		}
+	}
+
+	if got := (Decimal{}).Round(2); got.Valid {
+		t.Error("null.Round() should be null")
+	}
+	if got := (Decimal{}).Truncate(2); got.Valid {
+		t.Error("null.Truncate() should be null")
+	}
+}
+
+func TestDecimalCompare(t *testing.T) {
+	one, oneTen, two := mustDecimal("1.0"), mustDecimal("1.10"), mustDecimal("2")
+	if !one.Equal(mustDecimal("1.000")) {
+		t.Error("1.0 should equal 1.000")
+	}
+	if one.Equal(oneTen) {
+		t.Error("1.0 should not equal 1.10")
+	}
+	if !(Decimal{}).Equal(Decimal{}) {
+		t.Error("null should equal null")
+	}
+	if one.Equal(Decimal{}) {
+		t.Error("1.0 should not equal null")
+	}
+
+	assertCompare(t, one.Compare(oneTen), -1, "1.0 vs 1.10")
+	assertCompare(t, two.Compare(oneTen), 1, "2 vs 1.10")
+	assertCompare(t, one.Compare(mustDecimal("1.00")), 0, "1.0 vs 1.00")
+	assertCompare(t, mustDecimal("-0.5").Compare(mustDecimal("0.00")), -1, "-0.5 vs 0.00")
+	assertCompare(t, Decimal{}.Compare(mustDecimal("-5")), -1, "null vs -5")
+	assertCompare(t, Decimal{}.Compare(Decimal{}), 0, "null vs null")
+
+	if got := one.CmpNull(two); got != IntFrom(-1) {
+		t.Error("1.0 CmpNull 2:", got)
+	}
+	if got := two.CmpNull(mustDecimal("2.000")); got != IntFrom(0) {
+		t.Error("2 CmpNull 2.000:", got)
+	}
+	if got := one.CmpNull(Decimal{}); got.Valid {
+		t.Error("CmpNull(null) should be null")
+	}
+	if got := mustDecimal("-0.1").Sign(); got != IntFrom(-1) {
+		t.Error("Sign(-0.1):", got)
+	}
+}
+
+func TestDecimalConversions(t *testing.T) {
+	d := mustDecimal("-1.25")
+	if got := d.Rat(); got.Cmp(big.NewRat(-5, 4)) != 0 {
+		t.Error("Rat():", got)
+	}
+	if got := d.Float(); got != FloatFrom(-1.25) {
+		t.Error("Float():", got)
+	}
+	if got := d.Scale(); got != 2 {
+		t.Error("Scale():", got)
+	}
+
+	var null Decimal
+	if null.Rat() != nil || null.Unscaled() != nil || null.Float().Valid {
+		t.Error("null conversions should be nil or null")
+	}
+}
+
+func mustDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	maybePanic(err)
+	return d
+}
+
+func assertDecimal(t *testing.T, d Decimal, from string) {
+	t.Helper()
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+	if got, _ := d.MarshalText(); string(got) != decimalString {
+		t.Errorf("bad %s decimal: %s ≠ %s\n", from, got, decimalString)
+	}
+}
+
+func assertNullDecimal(t *testing.T, d Decimal, from string) {
+	t.Helper()
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
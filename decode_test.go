@@ -0,0 +1,136 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// these inputs mix values that take the fast path in UnmarshalJSON with ones that fall back to encoding/json
+var (
+	decodeNumbers = []string{
+		"0", "-0", "1", "-1", "127", "128", "-128", "-129", "255", "256", "4294967296",
+		"9223372036854775807", "9223372036854775808", "-9223372036854775808", "-9223372036854775809",
+		"18446744073709551615", "18446744073709551616", "99999999999999999999",
+		"01", "00", "-", "+1", " 1", "1 ", "1a", "1.0", "1.", ".5", "1e2", "1E+2", "1e-2", "-1.5e-300",
+		"1e400", "-1e400", "3.4e39", "0x10", "1_0", "Inf", "NaN", "true", "[]",
+	}
+	decodeStrings = []string{
+		`""`, `"hello"`, `"<&>"`, `"é 日本"`, "\"\xff\"", `"a\"b"`, `"é\n"`, "\"tab\t\"", `"\/"`,
+		`"`, `"x`, `x"`, `123`, `true`, `{}`, ` "x"`,
+	}
+	decodeBools = []string{"true", "false", "tru", "falsey", "1", `"true"`, " true", "True"}
+	decodeTimes = []string{
+		`"2012-12-21T21:21:21Z"`, `"2012-12-21T21:21:21.123456789+09:00"`, `"2012-12-21T21:21:21\u005a"`,
+		`"2012-12-21"`, `"0000-01-01T00:00:00Z"`, `"x"`, `""`, `123`, `"2012-12-21T21:21:21Z`,
+	}
+)
+
+func TestUnmarshalJSONMatchesEncodingJSON(t *testing.T) {
+	for _, in := range decodeNumbers {
+		data := []byte(in)
+
+		var i Int
+		var i64 int64
+		err, wantErr := i.UnmarshalJSON(data), json.Unmarshal(data, &i64)
+		assertSameDecode(t, "Int", data, i.Int64, i.Valid, err, &i64, wantErr)
+		var i8 Int8
+		var i8v int8
+		err, wantErr = i8.UnmarshalJSON(data), json.Unmarshal(data, &i8v)
+		assertSameDecode(t, "Int8", data, i8.Int8, i8.Valid, err, &i8v, wantErr)
+		var u Uint
+		var u64 uint64
+		err, wantErr = u.UnmarshalJSON(data), json.Unmarshal(data, &u64)
+		assertSameDecode(t, "Uint", data, u.Uint64, u.Valid, err, &u64, wantErr)
+		var u8 Uint8
+		var u8v uint8
+		err, wantErr = u8.UnmarshalJSON(data), json.Unmarshal(data, &u8v)
+		assertSameDecode(t, "Uint8", data, u8.Uint8, u8.Valid, err, &u8v, wantErr)
+		var f Float
+		var f64 float64
+		err, wantErr = f.UnmarshalJSON(data), json.Unmarshal(data, &f64)
+		assertSameDecode(t, "Float", data, f.Float64, f.Valid, err, &f64, wantErr)
+		var f32 Float32
+		var f32v float32
+		err, wantErr = f32.UnmarshalJSON(data), json.Unmarshal(data, &f32v)
+		assertSameDecode(t, "Float32", data, f32.Float32, f32.Valid, err, &f32v, wantErr)
+	}
+
+	for _, in := range decodeStrings {
+		data := []byte(in)
+		var s String
+		var str string
+		err, wantErr := s.UnmarshalJSON(data), json.Unmarshal(data, &str)
+		assertSameDecode(t, "String", data, s.String, s.Valid, err, &str, wantErr)
+	}
+
+	for _, in := range decodeBools {
+		data := []byte(in)
+		var b Bool
+		var v bool
+		err, wantErr := b.UnmarshalJSON(data), json.Unmarshal(data, &v)
+		assertSameDecode(t, "Bool", data, b.Bool, b.Valid, err, &v, wantErr)
+	}
+
+	for _, in := range decodeTimes {
+		data := []byte(in)
+		var ti Time
+		var v time.Time
+		err, wantErr := ti.UnmarshalJSON(data), json.Unmarshal(data, &v)
+		assertSameDecode(t, "Time", data, ti.Time, ti.Valid, err, &v, wantErr)
+	}
+}
+
+func TestUnmarshalJSONNumberStrings(t *testing.T) {
+	table := []struct {
+		in    string
+		want  int64
+		valid bool
+	}{
+		{`"12"`, 12, true},
+		{`"-12"`, -12, true},
+		{`"012"`, 12, true},
+		{`"+12"`, 12, true},
+		{`"1\u0032"`, 12, true},
+		{`"9223372036854775807"`, 9223372036854775807, true},
+		{`"9223372036854775808"`, 0, false},
+		{`"1.5"`, 0, false},
+		{`""`, 0, false},
+		{`"x"`, 0, false},
+	}
+	for _, test := range table {
+		var i Int
+		err := i.UnmarshalJSON([]byte(test.in))
+		if (err == nil) != test.valid || i.Int64 != test.want || i.Valid != test.valid {
+			t.Errorf("Int %s: got %v (valid: %v, err: %v), want %v (valid: %v)", test.in, i.Int64, i.Valid, err, test.want, test.valid)
+		}
+	}
+
+	var u8 Uint8
+	if err := u8.UnmarshalJSON([]byte(`"256"`)); err == nil {
+		t.Error("expected error for out of range Uint8, got nil")
+	}
+	var f Float32
+	maybePanic(f.UnmarshalJSON([]byte(`"0.1"`)))
+	if f.Float32 != 0.1 || !f.Valid {
+		t.Errorf("Float32: got %v (valid: %v), want 0.1", f.Float32, f.Valid)
+	}
+}
+
+// assertSameDecode checks that a null type decoded data the same way as encoding/json decoded it into want.
+func assertSameDecode[T comparable](t *testing.T, name string, data []byte, got T, valid bool, err error, want *T, wantErr error) {
+	t.Helper()
+	switch {
+	case wantErr != nil:
+		if err == nil {
+			t.Errorf("%s %s: expected error %v, got %v", name, data, wantErr, got)
+		} else if !strings.Contains(err.Error(), wantErr.Error()) {
+			t.Errorf("%s %s: error %q doesn't match %q", name, data, err, wantErr)
+		}
+	case err != nil:
+		t.Errorf("%s %s: unexpected error: %v", name, data, err)
+	case got != *want || !valid:
+		t.Errorf("%s %s: got %v (valid: %v), want %v", name, data, got, valid, *want)
+	}
+}
@@ -0,0 +1,188 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	durationValue = 90 * time.Second
+	durationJSON  = []byte(`"1m30s"`)
+)
+
+func TestDurationFrom(t *testing.T) {
+	assertDuration(t, DurationFrom(durationValue), "DurationFrom()")
+	assertDuration(t, DurationFromPtr(&durationValue), "DurationFromPtr()")
+	assertNullDuration(t, DurationFromPtr(nil), "DurationFromPtr(nil)")
+
+	zero := DurationFrom(0)
+	if !zero.Valid {
+		t.Error("DurationFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUnmarshalDuration(t *testing.T) {
+	for _, data := range [][]byte{durationJSON, []byte(`90000000000`)} {
+		var d Duration
+		err := json.Unmarshal(data, &d)
+		maybePanic(err)
+		assertDuration(t, d, string(data))
+	}
+
+	var null Duration
+	err := json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullDuration(t, null, "null json")
+
+	var invalid Duration
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullDuration(t, invalid, "invalid json")
+
+	var bad Duration
+	if err := json.Unmarshal([]byte(`"90 seconds"`), &bad); err == nil {
+		t.Error("expected error: bad duration string")
+	}
+	if err := json.Unmarshal(boolJSON, &bad); err == nil {
+		t.Error("expected error: wrong type JSON")
+	}
+
+	var text Duration
+	err = text.UnmarshalText([]byte("1m30s"))
+	maybePanic(err)
+	assertDuration(t, text, "UnmarshalText()")
+
+	var blank Duration
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullDuration(t, blank, "blank text")
+}
+
+func TestMarshalDuration(t *testing.T) {
+	data, err := json.Marshal(DurationFrom(durationValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(durationJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(Duration{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = DurationFrom(durationValue).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1m30s", "non-empty text marshal")
+
+	data, err = Duration{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestDurationScan(t *testing.T) {
+	table := []struct {
+		src  any
+		want time.Duration
+	}{
+		{int64(durationValue), durationValue},
+		{"1m30s", durationValue},
+		{"00:01:30", durationValue},
+		{[]byte("01:30:00"), 90 * time.Minute},
+		{"1 day 02:00:00", 26 * time.Hour},
+		{"-1 days +02:00:00", -22 * time.Hour},
+		{"3 days", 72 * time.Hour},
+		{"-00:00:01.5", -1500 * time.Millisecond},
+		{"00:00:00.000001", time.Microsecond},
+		{"100:00:00", 100 * time.Hour},
+	}
+	for _, tc := range table {
+		var d Duration
+		err := d.Scan(tc.src)
+		maybePanic(err)
+		if !d.Valid || d.Duration != tc.want {
+			t.Errorf("bad scan of %v: %v ≠ %v", tc.src, d.Duration, tc.want)
+		}
+	}
+
+	var null Duration
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullDuration(t, null, "scanned null")
+
+	for _, src := range []any{"1 mon", "2 years 00:00:00", "01:60:00", "10000000 days", 1.5} {
+		var bad Duration
+		if err := bad.Scan(src); err == nil {
+			t.Errorf("expected error scanning %v", src)
+		}
+		assertNullDuration(t, bad, "bad scan")
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	d := DurationFrom(-(26*time.Hour + 90*time.Second + 500*time.Millisecond))
+	table := []struct {
+		name  string
+		value driver.Valuer
+		null  driver.Valuer
+		want  any
+	}{
+		{"Duration", d, Duration{}, int64(d.Duration)},
+		{"DurationString", DurationString{d}, DurationString{}, "-26h1m30.5s"},
+		{"DurationInterval", DurationInterval{d}, DurationInterval{}, "-26:01:30.5"},
+	}
+	for _, tc := range table {
+		v, err := tc.value.Value()
+		maybePanic(err)
+		if v != tc.want {
+			t.Errorf("bad %s Value(): %#v ≠ %#v", tc.name, v, tc.want)
+		}
+
+		// values can be scanned back
+		var back Duration
+		err = back.Scan(v)
+		maybePanic(err)
+		if !back.Equal(d) {
+			t.Errorf("bad round trip for %s: %v ≠ %v", tc.name, back, d)
+		}
+
+		v, err = tc.null.Value()
+		maybePanic(err)
+		if v != nil {
+			t.Errorf("null %s Value() should be nil, got %v", tc.name, v)
+		}
+	}
+
+	v, err := DurationInterval{DurationFrom(time.Hour)}.Value()
+	maybePanic(err)
+	if v != "01:00:00" {
+		t.Errorf("bad interval Value(): %v", v)
+	}
+}
+
+func TestDurationCompare(t *testing.T) {
+	assertCompare(t, DurationFrom(time.Second).Compare(DurationFrom(time.Minute)), -1, "1s vs 1m")
+	assertCompare(t, Duration{}.Compare(DurationFrom(-time.Hour)), -1, "null vs -1h")
+	if !DurationFrom(0).Equal(NewDuration(0, true)) || DurationFrom(0).Equal(Duration{}) {
+		t.Error("bad Equal")
+	}
+}
+
+func assertDuration(t *testing.T, d Duration, from string) {
+	t.Helper()
+	if d.Duration != durationValue {
+		t.Errorf("bad %v duration: %v ≠ %v", from, d.Duration, durationValue)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDuration(t *testing.T, d Duration, from string) {
+	t.Helper()
+	if d.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
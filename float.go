@@ -2,10 +2,7 @@ package null
 
 import (
 	"database/sql"
-	"encoding/json"
 	"fmt"
-	"math"
-	"reflect"
 	"strconv"
 
 	"github.com/guregu/null/v6/internal"
@@ -61,7 +58,7 @@ func (f Float) ValueOr(v float64) float64 {
 // It supports number and null input.
 // 0 will not be considered a null Float.
 func (f *Float) UnmarshalJSON(data []byte) error {
-	return internal.UnmarshalFloatJSON(data, &f.Float64, &f.Valid)
+	return internal.UnmarshalFloatJSON(data, &f.Float64, &f.Valid, 64)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -85,25 +82,31 @@ func (f *Float) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Float is null.
 func (f Float) MarshalJSON() ([]byte, error) {
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Float to dst, as MarshalJSON does.
+// It will append null if this Float is null.
+func (f Float) AppendJSON(dst []byte) ([]byte, error) {
 	if !f.Valid {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
 	}
-	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
-		return nil, &json.UnsupportedValueError{
-			Value: reflect.ValueOf(f.Float64),
-			Str:   strconv.FormatFloat(f.Float64, 'g', -1, 64),
-		}
-	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return internal.AppendFloatJSON(dst, f.Float64)
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Float is null.
 func (f Float) MarshalText() ([]byte, error) {
+	return f.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Float is null.
+func (f Float) AppendText(dst []byte) ([]byte, error) {
 	if !f.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return strconv.AppendFloat(dst, f.Float64, 'f', -1, 64), nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
@@ -134,3 +137,9 @@ func (f Float) IsZero() bool {
 func (f Float) Equal(other Float) bool {
 	return f.Valid == other.Valid && (!f.Valid || f.Float64 == other.Float64)
 }
+
+// Compare returns an integer comparing two Floats, following the conventions of cmp.Compare.
+// NaN is less than any number, and null is less than any non-null value.
+func (f Float) Compare(other Float) int {
+	return internal.Compare(f.Float64, f.Valid, other.Float64, other.Valid)
+}
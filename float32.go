@@ -0,0 +1,162 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Float32 is a nullable float32, such as a SQL REAL column.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// JSON and text use 32-bit precision, so 0.1 is encoded as 0.1 rather than 0.10000000149011612.
+type Float32 struct {
+	Float32 float32
+	Valid   bool // Valid is true if Float32 is not NULL
+}
+
+// NewFloat32 creates a new Float32.
+func NewFloat32(f float32, valid bool) Float32 {
+	return Float32{
+		Float32: f,
+		Valid:   valid,
+	}
+}
+
+// Float32From creates a new Float32 that will always be valid.
+func Float32From(f float32) Float32 {
+	return NewFloat32(f, true)
+}
+
+// Float32FromPtr creates a new Float32 that be null if f is nil.
+func Float32FromPtr(f *float32) Float32 {
+	if f == nil {
+		return NewFloat32(0, false)
+	}
+	return NewFloat32(*f, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float32) ValueOrZero() float32 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float32
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (f Float32) ValueOr(v float32) float32 {
+	if !f.Valid {
+		return v
+	}
+	return f.Float32
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if a float64 or decimal string from the driver is out of range for float32.
+func (f *Float32) Scan(src any) error {
+	var n sql.Null[float32]
+	if err := n.Scan(src); err != nil {
+		f.Float32, f.Valid = 0, false
+		return fmt.Errorf("null: couldn't scan Float32: %w", err)
+	}
+	f.Float32, f.Valid = n.V, n.Valid
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (f Float32) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return float64(f.Float32), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will not be considered a null Float32.
+func (f *Float32) UnmarshalJSON(data []byte) error {
+	return internal.UnmarshalFloatJSON(data, &f.Float32, &f.Valid, 32)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float32 if the input is blank.
+// It will return an error if the input is not a float, blank, or "null".
+func (f *Float32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseFloat(str, 32)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	f.Float32, f.Valid = float32(n), true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Float32 is null.
+func (f Float32) MarshalJSON() ([]byte, error) {
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Float32 to dst, as MarshalJSON does.
+// It will append null if this Float32 is null.
+func (f Float32) AppendJSON(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return append(dst, "null"...), nil
+	}
+	return internal.AppendFloatJSON(dst, f.Float32)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Float32 is null.
+func (f Float32) MarshalText() ([]byte, error) {
+	return f.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Float32 is null.
+func (f Float32) AppendText(dst []byte) ([]byte, error) {
+	if !f.Valid {
+		return dst, nil
+	}
+	return strconv.AppendFloat(dst, float64(f.Float32), 'f', -1, 32), nil
+}
+
+// SetValid changes this Float32's value and also sets it to be non-null.
+func (f *Float32) SetValid(n float32) {
+	f.Float32 = n
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float32's value, or a nil pointer if this Float32 is null.
+func (f Float32) Ptr() *float32 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float32
+}
+
+// IsZero returns true for invalid Float32s.
+// A non-null Float32 with a 0 value will not be considered zero.
+func (f Float32) IsZero() bool {
+	return !f.Valid
+}
+
+// Equal returns true if both floats have the same value or are both null.
+// See Float's Equal method for caveats about comparing floating point numbers.
+func (f Float32) Equal(other Float32) bool {
+	return f.Valid == other.Valid && (!f.Valid || f.Float32 == other.Float32)
+}
+
+// Compare returns an integer comparing two Float32s, following the conventions of cmp.Compare.
+// NaN is less than any number, and null is less than any non-null value.
+func (f Float32) Compare(other Float32) int {
+	return internal.Compare(f.Float32, f.Valid, other.Float32, other.Valid)
+}
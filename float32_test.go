@@ -0,0 +1,163 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+var float32JSON = []byte(`0.1`)
+
+func TestFloat32From(t *testing.T) {
+	assertFloat32(t, Float32From(0.1), "Float32From()")
+
+	v := float32(0.1)
+	assertFloat32(t, Float32FromPtr(&v), "Float32FromPtr()")
+	assertNullFloat32(t, Float32FromPtr(nil), "Float32FromPtr(nil)")
+
+	if zero := Float32From(0); !zero.Valid {
+		t.Error("Float32From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUnmarshalFloat32(t *testing.T) {
+	var f Float32
+	err := json.Unmarshal(float32JSON, &f)
+	maybePanic(err)
+	assertFloat32(t, f, "float32 json")
+
+	var sf Float32
+	err = json.Unmarshal([]byte(`"0.1"`), &sf)
+	maybePanic(err)
+	assertFloat32(t, sf, "string float32 json")
+
+	var null Float32
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullFloat32(t, null, "null json")
+
+	var overflow Float32
+	if err := json.Unmarshal([]byte(`1e39`), &overflow); err == nil {
+		t.Error("expected error: overflowing number")
+	}
+	if err := json.Unmarshal([]byte(`"1e39"`), &overflow); err == nil {
+		t.Error("expected error: overflowing string")
+	}
+
+	var invalid Float32
+	if err := invalid.UnmarshalJSON(invalidJSON); err == nil {
+		t.Error("expected error: invalid json")
+	}
+}
+
+func TestTextFloat32(t *testing.T) {
+	var f Float32
+	err := f.UnmarshalText([]byte("0.1"))
+	maybePanic(err)
+	assertFloat32(t, f, "text")
+
+	var blank Float32
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullFloat32(t, blank, "blank text")
+
+	if err := blank.UnmarshalText([]byte("1e39")); err == nil {
+		t.Error("expected error: overflowing text")
+	}
+
+	data, err := f.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0.1", "text marshal")
+	data, err = Float32{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestMarshalFloat32(t *testing.T) {
+	data, err := json.Marshal(Float32From(0.1))
+	maybePanic(err)
+	assertJSONEquals(t, data, "0.1", "non-empty json marshal")
+
+	data, err = json.Marshal(Float32From(16777216))
+	maybePanic(err)
+	assertJSONEquals(t, data, "16777216", "large json marshal")
+
+	data, err = json.Marshal(Float32{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestFloat32Scan(t *testing.T) {
+	for _, src := range []any{float64(float32(0.1)), "0.1", []byte("0.1")} {
+		var f Float32
+		err := f.Scan(src)
+		maybePanic(err)
+		assertFloat32(t, f, "scanned")
+	}
+
+	var i Float32
+	maybePanic(i.Scan(int64(3)))
+	if i.Float32 != 3 {
+		t.Error("scanned int64:", i.Float32)
+	}
+
+	var null Float32
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullFloat32(t, null, "scanned null")
+
+	var overflow Float32
+	if err := overflow.Scan(math.MaxFloat64); err == nil {
+		t.Error("expected error: scanning float64 out of range")
+	}
+	assertNullFloat32(t, overflow, "scanned overflow")
+
+	v, err := Float32From(0.5).Value()
+	maybePanic(err)
+	if v != 0.5 {
+		t.Errorf("bad value: %#v", v)
+	}
+	if v, _ := (Float32{}).Value(); v != nil {
+		t.Errorf("null value: %#v", v)
+	}
+}
+
+func TestFloat32InfNaN(t *testing.T) {
+	nan := Float32From(float32(math.NaN()))
+	if _, err := nan.MarshalJSON(); err == nil {
+		t.Error("expected error for NaN, got nil")
+	}
+
+	inf := Float32From(float32(math.Inf(-1)))
+	if _, err := inf.MarshalJSON(); err == nil {
+		t.Error("expected error for Inf, got nil")
+	}
+}
+
+func TestFloat32Equal(t *testing.T) {
+	if !Float32From(0.1).Equal(Float32From(0.1)) || !(Float32{}).Equal(Float32{}) {
+		t.Error("expected equal")
+	}
+	if Float32From(0).Equal(Float32{}) || Float32From(0.1).Equal(Float32From(0.2)) {
+		t.Error("expected not equal")
+	}
+	assertCompare(t, Float32{}.Compare(Float32From(-1)), -1, "null vs -1")
+	assertCompare(t, Float32From(2).Compare(Float32From(1)), 1, "2 vs 1")
+}
+
+func assertFloat32(t *testing.T, f Float32, from string) {
+	t.Helper()
+	if f.Float32 != 0.1 {
+		t.Errorf("bad %s float32: %v ≠ %v\n", from, f.Float32, 0.1)
+	}
+	if !f.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullFloat32(t *testing.T, f Float32, from string) {
+	t.Helper()
+	if f.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
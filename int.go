@@ -73,19 +73,31 @@ func (i *Int) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Int is null.
 func (i Int) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Int to dst, as MarshalJSON does.
+// It will append null if this Int is null.
+func (i Int) AppendJSON(dst []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return strconv.AppendInt(dst, i.Int64, 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Int is null.
 func (i Int) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Int is null.
+func (i Int) AppendText(dst []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return strconv.AppendInt(dst, i.Int64, 10), nil
 }
 
 // SetValid changes this Int's value and also sets it to be non-null.
@@ -113,6 +125,12 @@ func (i Int) Equal(other Int) bool {
 	return i.Valid == other.Valid && (!i.Valid || i.Int64 == other.Int64)
 }
 
+// Compare returns an integer comparing two Ints, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (i Int) Compare(other Int) int {
+	return internal.Compare(i.Int64, i.Valid, other.Int64, other.Valid)
+}
+
 func (i Int) value() (int64, bool) {
 	return i.Int64, i.Valid
 }
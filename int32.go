@@ -2,6 +2,7 @@ package null
 
 import (
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/guregu/null/v6/internal"
@@ -53,6 +54,16 @@ func (i Int32) ValueOr(v int32) int32 {
 	return i.Int32
 }
 
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range instead of truncating it.
+func (i *Int32) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Int32, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't scan Int32: %w", err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Int32.
@@ -70,19 +81,31 @@ func (i *Int32) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Int32 is null.
 func (i Int32) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Int32 to dst, as MarshalJSON does.
+// It will append null if this Int32 is null.
+func (i Int32) AppendJSON(dst []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+	return strconv.AppendInt(dst, int64(i.Int32), 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Int32 is null.
 func (i Int32) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Int32 is null.
+func (i Int32) AppendText(dst []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+	return strconv.AppendInt(dst, int64(i.Int32), 10), nil
 }
 
 // SetValid changes this Int32's value and also sets it to be non-null.
@@ -110,6 +133,12 @@ func (i Int32) Equal(other Int32) bool {
 	return i.Valid == other.Valid && (!i.Valid || i.Int32 == other.Int32)
 }
 
+// Compare returns an integer comparing two Int32s, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (i Int32) Compare(other Int32) int {
+	return internal.Compare(i.Int32, i.Valid, other.Int32, other.Valid)
+}
+
 func (i Int32) value() (int64, bool) {
 	return int64(i.Int32), i.Valid
 }
@@ -0,0 +1,151 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Int8 is an nullable int8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Int8 struct {
+	Int8  int8
+	Valid bool // Valid is true if Int8 is not NULL
+}
+
+// NewInt8 creates a new Int8.
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{
+		Int8:  i,
+		Valid: valid,
+	}
+}
+
+// Int8From creates a new Int8 that will always be valid.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, true)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	return NewInt8(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int8) ValueOrZero() int8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int8
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (i Int8) ValueOr(v int8) int8 {
+	if !i.Valid {
+		return v
+	}
+	return i.Int8
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range instead of truncating it.
+func (i *Int8) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Int8, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't scan Int8: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (i Int8) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Int8), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Int8.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	return internal.UnmarshalIntJSON(data, &i.Int8, &i.Valid, 8, strconv.ParseInt)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int8 if the input is blank.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Int8) UnmarshalText(text []byte) error {
+	return internal.UnmarshalIntText(text, &i.Int8, &i.Valid, 8, strconv.ParseInt)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Int8 to dst, as MarshalJSON does.
+// It will append null if this Int8 is null.
+func (i Int8) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, "null"...), nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int8), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Int8 is null.
+func (i Int8) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Int8 is null.
+func (i Int8) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return dst, nil
+	}
+	return strconv.AppendInt(dst, int64(i.Int8), 10), nil
+}
+
+// SetValid changes this Int8's value and also sets it to be non-null.
+func (i *Int8) SetValid(n int8) {
+	i.Int8 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int8
+}
+
+// IsZero returns true for invalid Int8s, for future omitempty support (Go 1.4?)
+// A non-null Int8 with a 0 value will not be considered zero.
+func (i Int8) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Int8) Equal(other Int8) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Int8 == other.Int8)
+}
+
+// Compare returns an integer comparing two Int8s, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (i Int8) Compare(other Int8) int {
+	return internal.Compare(i.Int8, i.Valid, other.Int8, other.Valid)
+}
+
+func (i Int8) value() (int64, bool) {
+	return int64(i.Int8), i.Valid
+}
@@ -17,7 +17,7 @@ var (
 )
 
 type nullint interface {
-	Int | Int32 | Int16 | Byte
+	Int | Int32 | Int16 | Int8 | Byte
 	IsZero() bool
 	value() (int64, bool)
 }
@@ -26,6 +26,7 @@ func TestIntFrom(t *testing.T) {
 	testIntFrom(t, IntFrom)
 	testIntFrom(t, Int32From)
 	testIntFrom(t, Int16From)
+	testIntFrom(t, Int8From)
 	testIntFrom(t, ByteFrom)
 }
 
@@ -46,6 +47,7 @@ func TestIntFromPtr(t *testing.T) {
 	testIntFromPtr(t, IntFromPtr)
 	testIntFromPtr(t, Int32FromPtr)
 	testIntFromPtr(t, Int16FromPtr)
+	testIntFromPtr(t, Int8FromPtr)
 	testIntFromPtr(t, ByteFromPtr)
 }
 
@@ -65,6 +67,7 @@ func TestUnmarshalInt(t *testing.T) {
 	testUnmarshalInt[Int](t)
 	testUnmarshalInt[Int32](t)
 	testUnmarshalInt[Int16](t)
+	testUnmarshalInt[Int8](t)
 	testUnmarshalInt[Byte](t)
 }
 
@@ -120,6 +123,7 @@ func TestUnmarshalIntOverflow(t *testing.T) {
 	testUnmarshalIntOverflow[Int, int64](t, math.MaxInt64)
 	testUnmarshalIntOverflow[Int32, int32](t, math.MaxInt32)
 	testUnmarshalIntOverflow[Int16, int16](t, math.MaxInt16)
+	testUnmarshalIntOverflow[Int8, int8](t, math.MaxInt8)
 	testUnmarshalIntOverflow[Byte, byte](t, math.MaxUint8)
 }
 
@@ -145,6 +149,7 @@ func TestTextUnmarshalInt(t *testing.T) {
 	testTextUnmarshalInt(t, (*Int).UnmarshalText)
 	testTextUnmarshalInt(t, (*Int32).UnmarshalText)
 	testTextUnmarshalInt(t, (*Int16).UnmarshalText)
+	testTextUnmarshalInt(t, (*Int8).UnmarshalText)
 	testTextUnmarshalInt(t, (*Byte).UnmarshalText)
 }
 
@@ -177,6 +182,7 @@ func TestMarshalInt(t *testing.T) {
 	testMarshalInt(t, NewInt)
 	testMarshalInt(t, NewInt32)
 	testMarshalInt(t, NewInt16)
+	testMarshalInt(t, NewInt8)
 	testMarshalInt(t, NewByte)
 }
 
@@ -199,6 +205,7 @@ func TestMarshalIntText(t *testing.T) {
 	testMarshalIntText(t, NewInt)
 	testMarshalIntText(t, NewInt32)
 	testMarshalIntText(t, NewInt16)
+	testMarshalIntText(t, NewInt8)
 	testMarshalIntText(t, NewByte)
 }
 
@@ -221,6 +228,7 @@ func TestIntPointer(t *testing.T) {
 	testIntPointer(t, NewInt)
 	testIntPointer(t, NewInt32)
 	testIntPointer(t, NewInt16)
+	testIntPointer(t, NewInt8)
 	testIntPointer(t, NewByte)
 }
 
@@ -244,6 +252,7 @@ func TestIntIsZero(t *testing.T) {
 	testIntIsZero(t, NewInt)
 	testIntIsZero(t, NewInt32)
 	testIntIsZero(t, NewInt16)
+	testIntIsZero(t, NewInt8)
 	testIntIsZero(t, NewByte)
 }
 
@@ -270,6 +279,7 @@ func TestIntSetValid(t *testing.T) {
 	testIntSetValid(t, NewInt, (*Int).SetValid)
 	testIntSetValid(t, NewInt32, (*Int32).SetValid)
 	testIntSetValid(t, NewInt16, (*Int16).SetValid)
+	testIntSetValid(t, NewInt8, (*Int8).SetValid)
 	testIntSetValid(t, NewByte, (*Byte).SetValid)
 }
 
@@ -286,6 +296,7 @@ func TestIntScan(t *testing.T) {
 	testIntScan(t, (*Int).Scan)
 	testIntScan(t, (*Int32).Scan)
 	testIntScan(t, (*Int16).Scan)
+	testIntScan(t, (*Int8).Scan)
 	testIntScan(t, (*Byte).Scan)
 }
 
@@ -303,10 +314,40 @@ func testIntScan[N nullint](t *testing.T, scan func(*N, any) error) {
 	})
 }
 
+func TestIntScanRange(t *testing.T) {
+	testIntScanRange(t, (*Int32).Scan, math.MinInt32, math.MaxInt32)
+	testIntScanRange(t, (*Int16).Scan, math.MinInt16, math.MaxInt16)
+	testIntScanRange(t, (*Int8).Scan, math.MinInt8, math.MaxInt8)
+	testIntScanRange(t, (*Byte).Scan, 0, math.MaxUint8)
+}
+
+func testIntScanRange[N nullint](t *testing.T, scan func(*N, any) error, min, max int64) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		for _, src := range []any{min, max, strconv.FormatInt(max, 10), uint64(max)} {
+			var i N
+			if err := scan(&i, src); err != nil {
+				t.Errorf("scanning %#v: unexpected error: %v", src, err)
+			}
+		}
+		for _, src := range []any{min - 1, max + 1, strconv.FormatInt(max+1, 10), uint64(max + 1), uint64(math.MaxUint64)} {
+			var i N
+			maybePanic(scan(&i, int64(1)))
+			if err := scan(&i, src); err == nil {
+				t.Errorf("scanning %#v: expected out of range error", src)
+			}
+			assertNullInt(t, i, "scanned out of range")
+			if n, _ := i.value(); n != 0 {
+				t.Errorf("scanning %#v: value truncated to %d instead of reset", src, n)
+			}
+		}
+	})
+}
+
 func TestIntValueOrZero(t *testing.T) {
 	testIntValueOrZero(t, NewInt)
 	testIntValueOrZero(t, NewInt32)
 	testIntValueOrZero(t, NewInt16)
+	testIntValueOrZero(t, NewInt8)
 	testIntValueOrZero(t, NewByte)
 }
 
@@ -328,6 +369,7 @@ func TestIntValueOr(t *testing.T) {
 	testIntValueOr(t, NewInt)
 	testIntValueOr(t, NewInt32)
 	testIntValueOr(t, NewInt16)
+	testIntValueOr(t, NewInt8)
 	testIntValueOr(t, NewByte)
 }
 
@@ -349,6 +391,7 @@ func TestIntEqual(t *testing.T) {
 	testIntEqual(t, NewInt)
 	testIntEqual(t, NewInt32)
 	testIntEqual(t, NewInt16)
+	testIntEqual(t, NewInt8)
 	testIntEqual(t, NewByte)
 }
 
@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const hexDigits = "0123456789abcdef"
+
+// AppendJSONString appends s as a quoted JSON string, escaped the same way as encoding/json:
+// besides control characters, quotes, and backslashes, it escapes <, >, and &,
+// replaces invalid UTF-8 with U+FFFD, and escapes U+2028 and U+2029.
+func AppendJSONString[S ~string | ~[]byte](b []byte, s S) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		end := min(i+utf8.UTFMax, len(s))
+		r, size := utf8.DecodeRuneInString(string(s[i:end]))
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// AppendFloatJSON appends f as a JSON number without an exponent.
+// Like encoding/json, it returns a *json.UnsupportedValueError for NaN and infinities.
+func AppendFloatJSON[T float64 | float32](b []byte, f T) ([]byte, error) {
+	bits := 64
+	if _, ok := any(f).(float32); ok {
+		bits = 32
+	}
+	n := float64(f)
+	if math.IsInf(n, 0) || math.IsNaN(n) {
+		return b, &json.UnsupportedValueError{
+			Value: reflect.ValueOf(f),
+			Str:   strconv.FormatFloat(n, 'g', -1, bits),
+		}
+	}
+	return strconv.AppendFloat(b, n, 'f', -1, bits), nil
+}
+
+// AppendTime appends t in the RFC 3339 format with nanoseconds, as time.Time's MarshalText does.
+// Errors are prefixed with prefix, such as "Time.MarshalText: ".
+func AppendTime(b []byte, t time.Time, prefix string) ([]byte, error) {
+	n := len(b)
+	b = t.AppendFormat(b, time.RFC3339Nano)
+	// Not all valid Go times can be written as RFC 3339; time.Time checks for the same cases.
+	switch {
+	case b[n+len("9999")] != '-':
+		return b[:n], errors.New(prefix + "year outside of range [0,9999]")
+	case b[len(b)-1] != 'Z':
+		c := b[len(b)-len("Z07:00")]
+		hour := 10*(b[len(b)-len("07:00")]-'0') + (b[len(b)-len("7:00")] - '0')
+		if ('0' <= c && c <= '9') || hour >= 24 {
+			return b[:n], errors.New(prefix + "timezone hour outside of range [0,23]")
+		}
+	}
+	return b, nil
+}
+
+// AppendDuration appends d in the form used by time.Duration's String method, such as "1m30s".
+func AppendDuration(b []byte, d time.Duration) []byte {
+	// adapted from time.Duration.String, which always allocates
+	var buf [32]byte
+	w := len(buf)
+
+	u := uint64(d)
+	neg := d < 0
+	if neg {
+		u = -u
+	}
+
+	if u < uint64(time.Second) {
+		// durations under a second use smaller units, such as 1.2ms
+		var prec int
+		w--
+		buf[w] = 's'
+		w--
+		switch {
+		case u == 0:
+			buf[w] = '0'
+			return append(b, buf[w:]...)
+		case u < uint64(time.Microsecond):
+			prec = 0
+			buf[w] = 'n'
+		case u < uint64(time.Millisecond):
+			prec = 3
+			w-- // µ is two bytes
+			copy(buf[w:], "µ")
+		default:
+			prec = 6
+			buf[w] = 'm'
+		}
+		w, u = fmtFrac(buf[:w], u, prec)
+		w = fmtInt(buf[:w], u)
+	} else {
+		w--
+		buf[w] = 's'
+		w, u = fmtFrac(buf[:w], u, 9)
+
+		// u is now whole seconds
+		w = fmtInt(buf[:w], u%60)
+		u /= 60
+		if u > 0 {
+			// u is now whole minutes
+			w--
+			buf[w] = 'm'
+			w = fmtInt(buf[:w], u%60)
+			u /= 60
+			if u > 0 {
+				// u is now whole hours, the largest unit, as days vary in length
+				w--
+				buf[w] = 'h'
+				w = fmtInt(buf[:w], u)
+			}
+		}
+	}
+
+	if neg {
+		w--
+		buf[w] = '-'
+	}
+	return append(b, buf[w:]...)
+}
+
+// fmtFrac formats the fraction of v/10^prec into the tail of buf, omitting trailing zeros
+// and the decimal point if the fraction is zero.
+// It returns the index where the output begins and v/10^prec.
+func fmtFrac(buf []byte, v uint64, prec int) (int, uint64) {
+	w := len(buf)
+	print := false
+	for i := 0; i < prec; i++ {
+		digit := v % 10
+		print = print || digit != 0
+		if print {
+			w--
+			buf[w] = byte(digit) + '0'
+		}
+		v /= 10
+	}
+	if print {
+		w--
+		buf[w] = '.'
+	}
+	return w, v
+}
+
+// fmtInt formats v into the tail of buf and returns the index where the output begins.
+func fmtInt(buf []byte, v uint64) int {
+	w := len(buf)
+	if v == 0 {
+		w--
+		buf[w] = '0'
+		return w
+	}
+	for v > 0 {
+		w--
+		buf[w] = byte(v%10) + '0'
+		v /= 10
+	}
+	return w
+}
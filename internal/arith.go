@@ -0,0 +1,60 @@
+package internal
+
+func isSigned[T Integer]() bool {
+	return ^T(0) < 0
+}
+
+// AddChecked returns a + b and whether it did not overflow.
+func AddChecked[T Integer](a, b T) (T, bool) {
+	s := a + b
+	return s, (b >= 0) == (s >= a)
+}
+
+// SubChecked returns a - b and whether it did not overflow.
+func SubChecked[T Integer](a, b T) (T, bool) {
+	d := a - b
+	return d, (b >= 0) == (d <= a)
+}
+
+// MulChecked returns a * b and whether it did not overflow.
+func MulChecked[T Integer](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if isSigned[T]() {
+		// multiplying the minimum value by -1 overflows back to itself
+		minusOne := ^T(0)
+		if a == minusOne {
+			return p, p != b
+		}
+		if b == minusOne {
+			return p, p != a
+		}
+	}
+	return p, p/b == a
+}
+
+// DivChecked returns a / b and whether it did not overflow.
+// b must not be zero.
+func DivChecked[T Integer](a, b T) (T, bool) {
+	q := a / b
+	if isSigned[T]() && b == ^T(0) {
+		return q, a == 0 || q != a
+	}
+	return q, true
+}
+
+// NegChecked returns -a and whether it did not overflow.
+func NegChecked[T Integer](a T) (T, bool) {
+	n := -a
+	return n, a == 0 || (isSigned[T]() && n != a)
+}
+
+// AbsChecked returns the absolute value of a and whether it did not overflow.
+func AbsChecked[T Integer](a T) (T, bool) {
+	if a < 0 {
+		return NegChecked(a)
+	}
+	return a, true
+}
@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// BigZero is a shared zero for reading nil big integers as 0 without allocating.
+// It must never be modified.
+var BigZero = new(big.Int)
+
+var errBigIntSyntax = errors.New("invalid integer syntax")
+
+// ParseBigInt parses a base 10 integer with an optional sign.
+func ParseBigInt(s string) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errBigIntSyntax, s)
+	}
+	return i, nil
+}
+
+// ScanBigInt converts a non-nil driver value to a new big integer.
+// It accepts int64, uint64, and decimal strings as string or []byte.
+func ScanBigInt(src any) (*big.Int, error) {
+	switch x := src.(type) {
+	case int64:
+		return big.NewInt(x), nil
+	case uint64:
+		return new(big.Int).SetUint64(x), nil
+	case string:
+		return ParseBigInt(x)
+	case []byte:
+		return ParseBigInt(string(x))
+	}
+	return nil, fmt.Errorf("unsupported type %T", src)
+}
+
+// UnmarshalBigIntJSON decodes a JSON number or string holding an integer
+// without converting it to float64.
+func UnmarshalBigIntJSON(data []byte) (*big.Int, error) {
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return nil, err
+	}
+	return ParseBigInt(num.String())
+}
+
+// AppendBigInt appends the base 10 digits of i, which must not be nil.
+// Values that fit in 64 bits are formatted without allocating,
+// which big.Int's own Append can't do.
+func AppendBigInt(b []byte, i *big.Int) []byte {
+	switch {
+	case i.IsInt64():
+		return strconv.AppendInt(b, i.Int64(), 10)
+	case i.IsUint64():
+		return strconv.AppendUint(b, i.Uint64(), 10)
+	}
+	return i.Append(b, 10)
+}
+
+// AppendBigIntJSON appends the JSON encoding of i, which must not be nil, as a string if quote is true.
+func AppendBigIntJSON(b []byte, i *big.Int, quote bool) []byte {
+	if quote {
+		b = append(b, '"')
+		b = AppendBigInt(b, i)
+		return append(b, '"')
+	}
+	return AppendBigInt(b, i)
+}
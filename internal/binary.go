@@ -0,0 +1,294 @@
+package internal
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// BinaryVersion is the version of the binary format written by MarshalBinary.
+// It is stored in the upper four bits of the header byte.
+const BinaryVersion = 1
+
+const (
+	binaryValid = 1 << 0 // the value is not null and a payload follows
+	binaryUnset = 1 << 1 // an Optional that was never set
+)
+
+var errBinaryLength = errors.New("invalid payload length")
+
+// AppendBinaryHeader appends the header byte of a value that is valid or null.
+func AppendBinaryHeader(b []byte, valid bool) []byte {
+	h := byte(BinaryVersion << 4)
+	if valid {
+		h |= binaryValid
+	}
+	return append(b, h)
+}
+
+// AppendBinaryUnset appends the header byte of an unset Optional.
+func AppendBinaryUnset(b []byte) []byte {
+	return append(b, BinaryVersion<<4|binaryUnset)
+}
+
+// ReadBinary checks the header byte of data and returns the payload following it.
+// It reports false for null values, which have no payload.
+func ReadBinary(data []byte) (payload []byte, valid bool, err error) {
+	payload, valid, present, err := ReadOptionalBinary(data)
+	if err == nil && !present {
+		return nil, false, errors.New("unexpected unset value")
+	}
+	return payload, valid, err
+}
+
+// ReadOptionalBinary is like ReadBinary, but also accepts the header of an unset Optional,
+// reporting whether the value was present.
+func ReadOptionalBinary(data []byte) (payload []byte, valid, present bool, err error) {
+	if len(data) == 0 {
+		return nil, false, false, errors.New("no data")
+	}
+	h := data[0]
+	if v := h >> 4; v != BinaryVersion {
+		return nil, false, false, fmt.Errorf("unsupported binary format version %d", v)
+	}
+	switch h & 0x0f {
+	case binaryValid:
+		return data[1:], true, true, nil
+	case 0, binaryUnset:
+		if len(data) != 1 {
+			return nil, false, false, errors.New("null value with payload")
+		}
+		return nil, false, h&binaryUnset == 0, nil
+	}
+	return nil, false, false, fmt.Errorf("invalid binary header %#02x", h)
+}
+
+// AppendBinaryInt appends n as a varint, zig-zag encoded if T is signed.
+func AppendBinaryInt[T Integer](b []byte, n T) []byte {
+	if ^T(0) < 0 {
+		return binary.AppendVarint(b, int64(n))
+	}
+	return binary.AppendUvarint(b, uint64(n))
+}
+
+// ParseBinaryInt decodes a payload written by AppendBinaryInt, checking that it fits into T.
+func ParseBinaryInt[T Integer](payload []byte) (T, error) {
+	if ^T(0) < 0 {
+		x, n := binary.Varint(payload)
+		if n <= 0 || n != len(payload) {
+			return 0, errBinaryLength
+		}
+		if v := T(x); int64(v) == x {
+			return v, nil
+		}
+		return 0, fmt.Errorf("value %d out of range for %s", x, TypeName[T]())
+	}
+	x, n := binary.Uvarint(payload)
+	if n <= 0 || n != len(payload) {
+		return 0, errBinaryLength
+	}
+	if v := T(x); uint64(v) == x {
+		return v, nil
+	}
+	return 0, fmt.Errorf("value %d out of range for %s", x, TypeName[T]())
+}
+
+// MarshalBinaryInt encodes an integer type with a header and varint payload.
+func MarshalBinaryInt[T Integer](n T, valid bool) []byte {
+	b := AppendBinaryHeader(make([]byte, 0, 1+binary.MaxVarintLen64), valid)
+	if !valid {
+		return b
+	}
+	return AppendBinaryInt(b, n)
+}
+
+// UnmarshalBinaryInt decodes data written by MarshalBinaryInt into value.
+func UnmarshalBinaryInt[T Integer](data []byte, value *T, valid *bool) error {
+	*value, *valid = 0, false
+	payload, ok, err := ReadBinary(data)
+	if err != nil || !ok {
+		return err
+	}
+	n, err := ParseBinaryInt[T](payload)
+	if err != nil {
+		return err
+	}
+	*value, *valid = n, true
+	return nil
+}
+
+// MarshalBinaryFloat encodes a float type with a header and its IEEE 754 bits in big-endian order.
+func MarshalBinaryFloat[T float64 | float32](f T, valid bool) []byte {
+	b := AppendBinaryHeader(make([]byte, 0, 9), valid)
+	if !valid {
+		return b
+	}
+	switch x := any(f).(type) {
+	case float32:
+		return binary.BigEndian.AppendUint32(b, math.Float32bits(x))
+	default:
+		return binary.BigEndian.AppendUint64(b, math.Float64bits(float64(f)))
+	}
+}
+
+// UnmarshalBinaryFloat decodes data written by MarshalBinaryFloat into value.
+func UnmarshalBinaryFloat[T float64 | float32](data []byte, value *T, valid *bool) error {
+	*value, *valid = 0, false
+	payload, ok, err := ReadBinary(data)
+	if err != nil || !ok {
+		return err
+	}
+	switch x := any(value).(type) {
+	case *float32:
+		if len(payload) != 4 {
+			return errBinaryLength
+		}
+		*x = math.Float32frombits(binary.BigEndian.Uint32(payload))
+	case *float64:
+		if len(payload) != 8 {
+			return errBinaryLength
+		}
+		*x = math.Float64frombits(binary.BigEndian.Uint64(payload))
+	}
+	*valid = true
+	return nil
+}
+
+// AppendBinaryBool appends a single byte, 1 for true and 0 for false.
+func AppendBinaryBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 1)
+	}
+	return append(b, 0)
+}
+
+// ParseBinaryBool decodes a payload written by AppendBinaryBool.
+func ParseBinaryBool(payload []byte) (bool, error) {
+	if len(payload) != 1 || payload[0] > 1 {
+		return false, errors.New("invalid bool")
+	}
+	return payload[0] == 1, nil
+}
+
+// AppendBinaryBigInt appends a sign byte, 0 for positive or zero and 1 for negative,
+// followed by the absolute value of i in big-endian order.
+func AppendBinaryBigInt(b []byte, i *big.Int) []byte {
+	b = AppendBinaryBool(b, i.Sign() < 0)
+	return append(b, i.Bytes()...)
+}
+
+// ParseBinaryBigInt decodes a payload written by AppendBinaryBigInt.
+func ParseBinaryBigInt(payload []byte) (*big.Int, error) {
+	if len(payload) == 0 {
+		return nil, errBinaryLength
+	}
+	neg, err := ParseBinaryBool(payload[:1])
+	if err != nil {
+		return nil, errors.New("invalid sign")
+	}
+	i := new(big.Int).SetBytes(payload[1:])
+	if neg {
+		i.Neg(i)
+	}
+	return i, nil
+}
+
+// AppendBinaryDecimal appends the scale as a uvarint followed by the unscaled value as in AppendBinaryBigInt.
+func AppendBinaryDecimal(b []byte, unscaled *big.Int, scale int32) []byte {
+	b = binary.AppendUvarint(b, uint64(scale))
+	return AppendBinaryBigInt(b, unscaled)
+}
+
+// ParseBinaryDecimal decodes a payload written by AppendBinaryDecimal.
+func ParseBinaryDecimal(payload []byte) (unscaled *big.Int, scale int32, err error) {
+	x, n := binary.Uvarint(payload)
+	if n <= 0 {
+		return nil, 0, errBinaryLength
+	}
+	if x > MaxDecimalDigits {
+		return nil, 0, fmt.Errorf("scale %d out of range", x)
+	}
+	unscaled, err = ParseBinaryBigInt(payload[n:])
+	return unscaled, int32(x), err
+}
+
+// AppendBinaryTime appends the Unix time of t in seconds as a varint,
+// the nanoseconds within that second as a uvarint,
+// and the offset of t's time zone in seconds east of UTC as a varint.
+func AppendBinaryTime(b []byte, t time.Time) []byte {
+	_, offset := t.Zone()
+	b = binary.AppendVarint(b, t.Unix())
+	b = binary.AppendUvarint(b, uint64(t.Nanosecond()))
+	return binary.AppendVarint(b, int64(offset))
+}
+
+// ParseBinaryTime decodes a payload written by AppendBinaryTime.
+// The time is in UTC if its offset is zero, otherwise in a fixed zone with no name.
+func ParseBinaryTime(payload []byte) (time.Time, error) {
+	sec, n := binary.Varint(payload)
+	if n <= 0 {
+		return time.Time{}, errBinaryLength
+	}
+	payload = payload[n:]
+	nsec, n := binary.Uvarint(payload)
+	if n <= 0 || nsec >= uint64(time.Second) {
+		return time.Time{}, errBinaryLength
+	}
+	payload = payload[n:]
+	offset, n := binary.Varint(payload)
+	if n <= 0 || n != len(payload) || offset <= -24*60*60 || offset >= 24*60*60 {
+		return time.Time{}, errBinaryLength
+	}
+	t := time.Unix(sec, int64(nsec)).UTC()
+	if offset != 0 {
+		t = t.In(time.FixedZone("", int(offset)))
+	}
+	return t, nil
+}
+
+// AppendBinaryDate appends the year as a varint followed by one byte each for the month and day.
+func AppendBinaryDate(b []byte, year int, month time.Month, day int) []byte {
+	b = binary.AppendVarint(b, int64(year))
+	return append(b, byte(month), byte(day))
+}
+
+// ParseBinaryDate decodes a payload written by AppendBinaryDate.
+func ParseBinaryDate(payload []byte) (year int, month time.Month, day int, err error) {
+	y, n := binary.Varint(payload)
+	if n <= 0 || len(payload) != n+2 || int64(int(y)) != y {
+		return 0, 0, 0, errBinaryLength
+	}
+	year, month, day = int(y), time.Month(payload[n]), int(payload[n+1])
+	if y, m, d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Date(); y != year || m != month || d != day {
+		return 0, 0, 0, fmt.Errorf("invalid date %d-%02d-%02d", year, month, day)
+	}
+	return year, month, day, nil
+}
+
+// AppendBinaryValue appends the encoding of v, using its MarshalBinary method if it has one,
+// otherwise its JSON encoding.
+func AppendBinaryValue[T any](b []byte, v T) ([]byte, error) {
+	var data []byte
+	var err error
+	if m, ok := any(&v).(encoding.BinaryMarshaler); ok {
+		data, err = m.MarshalBinary()
+	} else {
+		data, err = json.Marshal(v)
+	}
+	return append(b, data...), err
+}
+
+// ParseBinaryValue decodes a payload written by AppendBinaryValue into v.
+func ParseBinaryValue[T any](payload []byte, v *T) error {
+	var zero T
+	*v = zero
+	if u, ok := any(v).(encoding.BinaryUnmarshaler); ok {
+		return u.UnmarshalBinary(payload)
+	}
+	return json.Unmarshal(payload, v)
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// BytesEncoding selects how byte slices are encoded as text.
+type BytesEncoding int
+
+const (
+	// BytesBase64 uses standard base64 encoding with padding, like encoding/json.
+	BytesBase64 BytesEncoding = iota
+	// BytesHex uses lowercase hexadecimal encoding.
+	BytesHex
+)
+
+// AppendBytes appends the encoding of b to dst.
+func AppendBytes(dst []byte, b []byte, enc BytesEncoding) ([]byte, error) {
+	switch enc {
+	case BytesBase64:
+		return base64.StdEncoding.AppendEncode(dst, b), nil
+	case BytesHex:
+		return hex.AppendEncode(dst, b), nil
+	}
+	return dst, fmt.Errorf("unknown bytes encoding: %d", enc)
+}
+
+// DecodeBytes decodes text according to enc.
+func DecodeBytes(text []byte, enc BytesEncoding) ([]byte, error) {
+	switch enc {
+	case BytesBase64:
+		return base64.StdEncoding.AppendDecode(nil, text)
+	case BytesHex:
+		return hex.AppendDecode(nil, text)
+	}
+	return nil, fmt.Errorf("unknown bytes encoding: %d", enc)
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"cmp"
+)
+
+// Compare compares two nullable values using cmp.Compare.
+// Null is less than any non-null value.
+func Compare[T cmp.Ordered](a T, aValid bool, b T, bValid bool) int {
+	return CompareFunc(a, aValid, b, bValid, cmp.Compare[T])
+}
+
+// CompareFunc compares two nullable values using cmp.
+// Null is less than any non-null value.
+func CompareFunc[T any](a T, aValid bool, b T, bValid bool, cmp func(T, T) int) int {
+	switch {
+	case !aValid && !bValid:
+		return 0
+	case !aValid:
+		return -1
+	case !bValid:
+		return 1
+	}
+	return cmp(a, b)
+}
+
+// CompareBool compares two bools, with false less than true.
+func CompareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the layout used to encode dates.
+const DateLayout = time.DateOnly
+
+// ParseDate parses a date in DateLayout format.
+func ParseDate(s string) (year int, month time.Month, day int, err error) {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	year, month, day = t.Date()
+	return year, month, day, nil
+}
+
+// AppendDate appends the DateLayout encoding of the given date to b.
+func AppendDate(b []byte, year int, month time.Month, day int) []byte {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).AppendFormat(b, DateLayout)
+}
+
+// ScanDate converts a date or timestamp returned by a driver into a date.
+// Times are converted using their own location.
+// Strings may have a time component after the date, which is ignored.
+func ScanDate(src any) (year int, month time.Month, day int, err error) {
+	switch x := src.(type) {
+	case time.Time:
+		year, month, day = x.Date()
+		return year, month, day, nil
+	case string:
+		return ParseDate(trimDate(x))
+	case []byte:
+		return ParseDate(trimDate(string(x)))
+	}
+	return 0, 0, 0, fmt.Errorf("unsupported type %T", src)
+}
+
+// trimDate removes the time component from a timestamp such as "2006-01-02 15:04:05".
+func trimDate(s string) string {
+	if len(s) > len(DateLayout) && (s[len(DateLayout)] == 'T' || s[len(DateLayout)] == ' ') {
+		return s[:len(DateLayout)]
+	}
+	return s
+}
+
+// CompareDate compares two dates in chronological order.
+func CompareDate(ay int, am time.Month, ad int, by int, bm time.Month, bd int) int {
+	return cmp.Or(cmp.Compare(ay, by), cmp.Compare(am, bm), cmp.Compare(ad, bd))
+}
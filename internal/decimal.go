@@ -0,0 +1,6 @@
+package internal
+
+// MaxDecimalDigits limits the number of digits created when parsing exponents or rescaling,
+// so that small inputs such as "1e999999999" can't allocate huge numbers.
+// It matches the maximum number of digits before the decimal point in a Postgres NUMERIC.
+const MaxDecimalDigits = 131072
@@ -0,0 +1,190 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errDurationOverflow = errors.New("duration out of range")
+
+// FormatInterval formats d as a Postgres INTERVAL in the form "[-]HH:MM:SS[.fffffffff]".
+// The hours are not limited to a single day.
+func FormatInterval(d time.Duration) string {
+	var b []byte
+	// use uint64 so the minimum duration can be negated
+	n := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		n = -n
+	}
+	frac := n % uint64(time.Second)
+	secs := n / uint64(time.Second)
+	hours := secs / 3600
+	if hours < 10 {
+		b = append(b, '0')
+	}
+	b = strconv.AppendUint(b, hours, 10)
+	b = append(b, ':', byte('0'+secs/60%60/10), byte('0'+secs/60%10), ':', byte('0'+secs%60/10), byte('0'+secs%10))
+	if frac != 0 {
+		digits := strconv.AppendUint(nil, frac+uint64(time.Second), 10)[1:] // zero-padded to 9 digits
+		b = append(b, '.')
+		b = append(b, strings.TrimRight(string(digits), "0")...)
+	}
+	return string(b)
+}
+
+// ParseInterval parses a Postgres INTERVAL in its default output style,
+// such as "01:30:00", "-00:00:01.5", or "1 day 02:00:00".
+// Days are considered to be 24 hours long.
+// Years and months don't have a fixed length, so they are rejected.
+func ParseInterval(s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("invalid interval %q", s)
+	}
+	var total time.Duration
+	for i := 0; i < len(fields); i++ {
+		var part time.Duration
+		var err error
+		if strings.Contains(fields[i], ":") {
+			part, err = parseClock(fields[i])
+		} else {
+			if i+1 == len(fields) {
+				return 0, fmt.Errorf("invalid interval %q: missing unit", s)
+			}
+			part, err = parseIntervalUnit(fields[i], fields[i+1])
+			i++
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q: %w", s, err)
+		}
+		sum, ok := AddChecked(int64(total), int64(part))
+		if !ok {
+			return 0, fmt.Errorf("invalid interval %q: %w", s, errDurationOverflow)
+		}
+		total = time.Duration(sum)
+	}
+	return total, nil
+}
+
+func parseIntervalUnit(num, unit string) (time.Duration, error) {
+	n, err := strconv.ParseInt(num, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var scale time.Duration
+	switch unit {
+	case "day", "days":
+		scale = 24 * time.Hour
+	case "hour", "hours":
+		scale = time.Hour
+	case "min", "mins", "minute", "minutes":
+		scale = time.Minute
+	case "sec", "secs", "second", "seconds":
+		scale = time.Second
+	case "year", "years", "mon", "mons", "month", "months":
+		return 0, fmt.Errorf("unit %q has no fixed duration", unit)
+	default:
+		return 0, fmt.Errorf("unknown unit %q", unit)
+	}
+	d, ok := MulChecked(n, int64(scale))
+	if !ok {
+		return 0, errDurationOverflow
+	}
+	return time.Duration(d), nil
+}
+
+// parseClock parses "[+-]HH:MM[:SS[.fffffffff]]".
+func parseClock(s string) (time.Duration, error) {
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || hours < 0 {
+		return 0, fmt.Errorf("bad hours %q", parts[0])
+	}
+	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("bad minutes %q", parts[1])
+	}
+	var secs, nanos int64
+	if len(parts) == 3 {
+		whole, frac, _ := strings.Cut(parts[2], ".")
+		secs, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil || secs < 0 || secs > 59 {
+			return 0, fmt.Errorf("bad seconds %q", parts[2])
+		}
+		if frac != "" {
+			if len(frac) > 9 {
+				frac = frac[:9]
+			}
+			nanos, err = strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+			if err != nil || nanos < 0 {
+				return 0, fmt.Errorf("bad seconds %q", parts[2])
+			}
+		}
+	}
+	d, ok := MulChecked(hours, int64(time.Hour))
+	if !ok {
+		return 0, errDurationOverflow
+	}
+	if d, ok = AddChecked(d, minutes*int64(time.Minute)+secs*int64(time.Second)+nanos); !ok {
+		return 0, errDurationOverflow
+	}
+	if neg {
+		d = -d
+	}
+	return time.Duration(d), nil
+}
+
+// ScanDuration converts a value returned by a driver into a duration.
+// Integers are nanoseconds. Strings may be either time.Duration strings such as "1m30s"
+// or Postgres INTERVAL text such as "1 day 02:00:00".
+func ScanDuration(src any) (time.Duration, error) {
+	switch x := src.(type) {
+	case int64:
+		return time.Duration(x), nil
+	case string:
+		return parseDurationText(x)
+	case []byte:
+		return parseDurationText(string(x))
+	}
+	return 0, fmt.Errorf("unsupported type %T", src)
+}
+
+func parseDurationText(s string) (time.Duration, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	return ParseInterval(s)
+}
+
+// UnmarshalDurationJSON decodes a JSON time.Duration string, such as "1m30s",
+// or a number of nanoseconds.
+func UnmarshalDurationJSON(data []byte) (time.Duration, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return 0, err
+		}
+		return time.ParseDuration(str)
+	}
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return 0, err
+	}
+	return time.Duration(n), nil
+}
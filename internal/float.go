@@ -6,7 +6,7 @@ import (
 	"strconv"
 )
 
-func UnmarshalFloatJSON(data []byte, value *float64, valid *bool) error {
+func UnmarshalFloatJSON[T float64 | float32](data []byte, value *T, valid *bool, bits int) error {
 	if len(data) == 0 {
 		return fmt.Errorf("UnmarshalJSON: no data")
 	}
@@ -19,18 +19,28 @@ func UnmarshalFloatJSON(data []byte, value *float64, valid *bool) error {
 
 	case '"':
 		var str string
-		if err := json.Unmarshal(data, &str); err != nil {
+		if s, ok := UnquoteSimpleJSON(data); ok {
+			str = string(s)
+		} else if err := json.Unmarshal(data, &str); err != nil {
 			return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
 		}
-		n, err := strconv.ParseFloat(str, 64)
+		n, err := strconv.ParseFloat(str, bits)
 		if err != nil {
 			return fmt.Errorf("null: couldn't convert string to int: %w", err)
 		}
-		*value = n
+		*value = T(n)
 		*valid = true
 		return nil
 
 	default:
+		if IsJSONNumber(data) {
+			// out of range numbers are left to encoding/json for its error
+			if n, err := strconv.ParseFloat(string(data), bits); err == nil {
+				*value = T(n)
+				*valid = true
+				return nil
+			}
+		}
 		err := json.Unmarshal(data, value)
 		*valid = err == nil
 		return err
@@ -1,12 +1,17 @@
 package internal
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 )
 
 type Integer interface {
-	int64 | int32 | int16 | byte
+	int64 | int32 | int16 | int8 | Unsigned
+}
+
+type Unsigned interface {
+	uint64 | uint32 | uint16 | uint8
 }
 
 func UnmarshalIntJSON[T Integer, U int64 | uint64](data []byte, value *T, valid *bool, bits int, parse func(string, int, int) (U, error)) error {
@@ -22,7 +27,14 @@ func UnmarshalIntJSON[T Integer, U int64 | uint64](data []byte, value *T, valid
 
 	case '"':
 		var str string
-		if err := json.Unmarshal(data, &str); err != nil {
+		if s, ok := UnquoteSimpleJSON(data); ok {
+			if n, ok := ParseJSONInt[T](s); ok {
+				*value = n
+				*valid = true
+				return nil
+			}
+			str = string(s)
+		} else if err := json.Unmarshal(data, &str); err != nil {
 			return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
 		}
 		n, err := parse(str, 10, bits)
@@ -34,6 +46,11 @@ func UnmarshalIntJSON[T Integer, U int64 | uint64](data []byte, value *T, valid
 		return nil
 
 	default:
+		if n, ok := ParseJSONInt[T](data); ok {
+			*value = n
+			*valid = true
+			return nil
+		}
 		err := json.Unmarshal(data, value)
 		*valid = err == nil
 		return err
@@ -56,3 +73,36 @@ func UnmarshalIntText[T Integer, U int64 | uint64](text []byte, value *T, valid
 	*valid = true
 	return nil
 }
+
+// ScanInt scans src into value, checking that it fits into T.
+// Out-of-range values produce an error instead of being truncated,
+// and leave value as a null zero. Drivers may return uint64 or decimal []byte/string
+// values for unsigned columns, so this avoids a round trip through int64.
+func ScanInt[T Integer](src any, value *T, valid *bool) error {
+	*value, *valid = 0, false
+	switch x := src.(type) {
+	case nil:
+		return nil
+	case int64:
+		n := T(x)
+		if int64(n) != x || (n < 0) != (x < 0) {
+			return fmt.Errorf("value %d out of range for %s", x, TypeName[T]())
+		}
+		*value, *valid = n, true
+		return nil
+	case uint64:
+		n := T(x)
+		if uint64(n) != x || n < 0 {
+			return fmt.Errorf("value %d out of range for %s", x, TypeName[T]())
+		}
+		*value, *valid = n, true
+		return nil
+	}
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*value = n.V
+	*valid = n.Valid
+	return nil
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"unicode/utf8"
+)
+
+// These scanners decode the common cases of JSON literals directly from the input,
+// reporting false for anything else, such as escaped strings, surrounding whitespace,
+// out of range numbers, or invalid syntax.
+// Callers fall back to encoding/json in that case, so its errors are unchanged.
+
+// UnquoteSimpleJSON returns the contents of a JSON string that contains no escape sequences.
+func UnquoteSimpleJSON(data []byte) ([]byte, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return nil, false
+	}
+	s := data[1 : len(data)-1]
+	for _, c := range s {
+		if c < ' ' || c == '"' || c == '\\' {
+			return nil, false
+		}
+	}
+	// encoding/json replaces invalid UTF-8 with U+FFFD
+	if !utf8.Valid(s) {
+		return nil, false
+	}
+	return s, true
+}
+
+// ParseJSONBool decodes the JSON literals true and false.
+func ParseJSONBool(data []byte) (value, ok bool) {
+	switch string(data) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+	return false, false
+}
+
+// ParseJSONInt decodes a JSON number without a fraction or exponent, checking that it fits into T.
+func ParseJSONInt[T Integer](data []byte) (T, bool) {
+	digits := data
+	neg := len(digits) > 0 && digits[0] == '-'
+	if neg {
+		if ^T(0) > 0 {
+			// encoding/json rejects even -0 for unsigned types
+			return 0, false
+		}
+		digits = digits[1:]
+	}
+	// 19 digits always fit into a uint64, longer numbers are left to the caller
+	if len(digits) == 0 || len(digits) > 19 || (digits[0] == '0' && len(digits) > 1) {
+		return 0, false
+	}
+	var u uint64
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		u = u*10 + uint64(c-'0')
+	}
+	if neg {
+		if u > 1<<63 {
+			return 0, false
+		}
+		x := -int64(u)
+		n := T(x)
+		if int64(n) != x || (n < 0) != (x < 0) {
+			return 0, false
+		}
+		return n, true
+	}
+	n := T(u)
+	if uint64(n) != u || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// IsJSONNumber reports whether data is a valid JSON number.
+func IsJSONNumber(data []byte) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	switch {
+	case i == len(data):
+		return false
+	case data[i] == '0':
+		i++
+	case '1' <= data[i] && data[i] <= '9':
+		i = skipDigits(data, i)
+	default:
+		return false
+	}
+	if i < len(data) && data[i] == '.' {
+		j := skipDigits(data, i+1)
+		if j == i+1 {
+			return false
+		}
+		i = j
+	}
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		j := skipDigits(data, i)
+		if j == i {
+			return false
+		}
+		i = j
+	}
+	return i == len(data)
+}
+
+func skipDigits(data []byte, i int) int {
+	for i < len(data) && '0' <= data[i] && data[i] <= '9' {
+		i++
+	}
+	return i
+}
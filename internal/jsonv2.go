@@ -0,0 +1,207 @@
+// go1.27: encoding/json/v2 and encoding/json/jsontext (json.MarshalerTo, jsontext.Encoder, ...)
+// first appear in the Go API in api/go1.27.txt, and vet rejects them in files built for older versions.
+//go:build goexperiment.jsonv2 && go1.27
+
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+	"unsafe"
+)
+
+// LegacyJSON reports whether opts ask for encoding/json v1 semantics.
+// The jsontext methods give up with errors.ErrUnsupported in that case,
+// so that v1 callers keep getting the exact output of MarshalJSON and UnmarshalJSON.
+func LegacyJSON(opts jsonv2.Options) bool {
+	legacy, _ := jsonv2.GetOption(opts, json.CallMethodsWithLegacySemantics)
+	return legacy
+}
+
+// stringifyNumbers reports whether the next number written by enc must be a JSON string,
+// either because it is an object name or because of the StringifyNumbers option or `string` tag.
+func stringifyNumbers(enc *jsontext.Encoder) bool {
+	if kind, n := enc.StackIndex(enc.StackDepth()); kind == '{' && n%2 == 0 {
+		return true
+	}
+	v, _ := jsonv2.GetOption(enc.Options(), jsonv2.StringifyNumbers)
+	return v
+}
+
+// MarshalNullTo writes a JSON null.
+func MarshalNullTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.Null)
+}
+
+// MarshalIntTo writes n as a JSON number, or as a string if enc's options ask for one.
+func MarshalIntTo(enc *jsontext.Encoder, n int64) error {
+	var buf [24]byte
+	return MarshalNumberTo(enc, strconv.AppendInt(buf[:0], n, 10), false)
+}
+
+// MarshalUintTo writes n as a JSON number, or as a string if enc's options ask for one.
+func MarshalUintTo(enc *jsontext.Encoder, n uint64) error {
+	var buf [24]byte
+	return MarshalNumberTo(enc, strconv.AppendUint(buf[:0], n, 10), false)
+}
+
+// MarshalFloatTo writes f as a JSON number, or as a string if enc's options ask for one.
+// Like MarshalJSON, it returns an error for NaN and infinity.
+func MarshalFloatTo(enc *jsontext.Encoder, f float64, bits int) error {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		var v any = f
+		if bits == 32 {
+			v = float32(f)
+		}
+		return &json.UnsupportedValueError{
+			Value: reflect.ValueOf(v),
+			Str:   strconv.FormatFloat(f, 'g', -1, bits),
+		}
+	}
+	var buf [32]byte
+	return MarshalNumberTo(enc, strconv.AppendFloat(buf[:0], f, 'f', -1, bits), false)
+}
+
+// MarshalNumberTo writes num, which must be valid JSON number text.
+// It is written as a string if quoted is true or if enc's options ask for one.
+func MarshalNumberTo(enc *jsontext.Encoder, num []byte, quoted bool) error {
+	if !quoted && !stringifyNumbers(enc) {
+		return enc.WriteValue(num)
+	}
+	return MarshalQuotedTo(enc, num)
+}
+
+// MarshalQuotedTo writes text as a JSON string.
+// text must not contain any characters that need escaping.
+func MarshalQuotedTo(enc *jsontext.Encoder, text []byte) error {
+	var buf [64]byte
+	b := append(buf[:0], '"')
+	b = append(b, text...)
+	return enc.WriteValue(append(b, '"'))
+}
+
+// UnquoteJSON returns the contents of the JSON string val.
+// The result aliases val unless val contains escape sequences.
+func UnquoteJSON(val jsontext.Value) ([]byte, error) {
+	if val.Kind() != '"' {
+		return nil, fmt.Errorf("unexpected JSON %s, want string", val.Kind())
+	}
+	if bytes.IndexByte(val, '\\') < 0 {
+		return val[1 : len(val)-1], nil
+	}
+	return jsontext.AppendUnquote(nil, val)
+}
+
+// ReadNullFrom consumes the next value if it is a JSON null, reporting whether it was.
+func ReadNullFrom(dec *jsontext.Decoder) (bool, error) {
+	if dec.PeekKind() != 'n' {
+		return false, nil
+	}
+	_, err := dec.ReadToken()
+	return true, err
+}
+
+// ReadStringFrom reads a JSON string, returning its contents.
+// It reports false for null.
+func ReadStringFrom(dec *jsontext.Decoder) ([]byte, bool, error) {
+	val, err := dec.ReadValue()
+	if err != nil || val.Kind() == 'n' {
+		return nil, false, err
+	}
+	text, err := UnquoteJSON(val)
+	return text, err == nil, err
+}
+
+// ReadNumberFrom reads a JSON number or a string holding one, returning its text.
+// It reports false for null.
+func ReadNumberFrom(dec *jsontext.Decoder) ([]byte, bool, error) {
+	val, err := dec.ReadValue()
+	if err != nil {
+		return nil, false, err
+	}
+	switch val.Kind() {
+	case 'n':
+		return nil, false, nil
+	case '0':
+		return val, true, nil
+	case '"':
+		text, err := UnquoteJSON(val)
+		return text, true, err
+	}
+	return nil, false, fmt.Errorf("unexpected JSON %s, want number", val.Kind())
+}
+
+// UnmarshalIntFrom reads a JSON number, number string, or null into value.
+// It works the same as UnmarshalIntJSON, without going through encoding/json.
+func UnmarshalIntFrom[T Integer](dec *jsontext.Decoder, value *T, valid *bool) error {
+	text, ok, err := ReadNumberFrom(dec)
+	if err != nil || !ok {
+		*value, *valid = 0, false
+		return err
+	}
+	var zero T
+	bits := int(unsafe.Sizeof(zero)) * 8
+	if ^zero < 0 {
+		n, err := strconv.ParseInt(string(text), 10, bits)
+		*value, *valid = T(n), err == nil
+		return err
+	}
+	n, err := strconv.ParseUint(string(text), 10, bits)
+	*value, *valid = T(n), err == nil
+	return err
+}
+
+// UnmarshalFloatFrom reads a JSON number, number string, or null into value.
+// It works the same as UnmarshalFloatJSON, without going through encoding/json.
+func UnmarshalFloatFrom[T float64 | float32](dec *jsontext.Decoder, value *T, valid *bool, bits int) error {
+	text, ok, err := ReadNumberFrom(dec)
+	if err != nil || !ok {
+		*value, *valid = 0, false
+		return err
+	}
+	n, err := strconv.ParseFloat(string(text), bits)
+	*value, *valid = T(n), err == nil
+	return err
+}
+
+// MarshalDurationTo writes d as json/v2 would write a time.Duration with enc's options,
+// such as a format tag or FormatDurationAsNano.
+// json/v2 has no default representation for durations,
+// so if none is selected d is written as a string such as "1m30s", the same as MarshalJSON.
+func MarshalDurationTo(enc *jsontext.Encoder, d time.Duration) error {
+	val, err := jsonv2.Marshal(d, enc.Options())
+	if err != nil {
+		return MarshalQuotedTo(enc, []byte(d.String()))
+	}
+	return enc.WriteValue(val)
+}
+
+// UnmarshalDurationFrom decodes val as json/v2 would decode a time.Duration with opts,
+// falling back to the strings and numbers of nanoseconds accepted by UnmarshalDurationJSON.
+func UnmarshalDurationFrom(val jsontext.Value, opts jsonv2.Options) (time.Duration, error) {
+	var d time.Duration
+	err := jsonv2.Unmarshal(val, &d, opts)
+	if err == nil {
+		return d, nil
+	}
+	switch val.Kind() {
+	case '"':
+		if text, uerr := UnquoteJSON(val); uerr == nil {
+			if v, perr := time.ParseDuration(string(text)); perr == nil {
+				return v, nil
+			}
+		}
+	case '0':
+		if n, perr := strconv.ParseInt(string(val), 10, 64); perr == nil {
+			return time.Duration(n), nil
+		}
+	}
+	return 0, err
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// ParseAddr parses an IP address.
+// Postgres INET values may include a prefix length, which is accepted only if it covers the whole address,
+// such as "192.0.2.1/32".
+func ParseAddr(s string) (netip.Addr, error) {
+	if !strings.Contains(s, "/") {
+		return netip.ParseAddr(s)
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	if !p.IsSingleIP() {
+		return netip.Addr{}, fmt.Errorf("netip.ParseAddr(%q): prefix is not a single address", s)
+	}
+	return p.Addr(), nil
+}
+
+// ParsePrefix parses an IP prefix in CIDR notation.
+// A bare address, as Postgres uses for INET host values, is treated as a single-address prefix.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return netip.ParsePrefix(s)
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// ScanString converts a string or []byte value returned by a driver into a string.
+func ScanString(src any) (string, error) {
+	switch x := src.(type) {
+	case string:
+		return x, nil
+	case []byte:
+		return string(x), nil
+	}
+	return "", fmt.Errorf("unsupported type %T", src)
+}
+
+// ComparePrefix compares two prefixes by address family, then prefix length, then address.
+func ComparePrefix(a, b netip.Prefix) int {
+	return cmp.Or(
+		cmp.Compare(a.Addr().BitLen(), b.Addr().BitLen()),
+		cmp.Compare(a.Bits(), b.Bits()),
+		a.Addr().Compare(b.Addr()),
+	)
+}
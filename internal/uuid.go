@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// UUIDLen is the length of the canonical string form of a UUID.
+const UUIDLen = 36
+
+var errUUIDFormat = errors.New("invalid UUID format")
+
+// ParseUUID parses a UUID in its canonical form, optionally wrapped in braces
+// or prefixed with "urn:uuid:", or as 32 hex digits without hyphens.
+// Hex digits may be upper or lower case.
+func ParseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	switch len(s) {
+	case UUIDLen:
+	case UUIDLen + 2:
+		if s[0] != '{' || s[len(s)-1] != '}' {
+			return u, errUUIDFormat
+		}
+		s = s[1 : len(s)-1]
+	case UUIDLen + len("urn:uuid:"):
+		if !strings.EqualFold(s[:9], "urn:uuid:") {
+			return u, errUUIDFormat
+		}
+		s = s[9:]
+	case 32:
+		if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+			return [16]byte{}, errUUIDFormat
+		}
+		return u, nil
+	default:
+		return u, errUUIDFormat
+	}
+	if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, errUUIDFormat
+	}
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	if _, err := hex.Decode(u[:], []byte(digits)); err != nil {
+		return [16]byte{}, errUUIDFormat
+	}
+	return u, nil
+}
+
+// AppendUUID appends the canonical string form of u to b.
+func AppendUUID(b []byte, u [16]byte) []byte {
+	b = hex.AppendEncode(b, u[0:4])
+	b = append(b, '-')
+	b = hex.AppendEncode(b, u[4:6])
+	b = append(b, '-')
+	b = hex.AppendEncode(b, u[6:8])
+	b = append(b, '-')
+	b = hex.AppendEncode(b, u[8:10])
+	b = append(b, '-')
+	return hex.AppendEncode(b, u[10:])
+}
+
+// ScanUUID converts a value returned by a driver into a UUID.
+// A []byte of length 16 is considered to be a raw binary UUID,
+// and other strings and []byte values are parsed with ParseUUID.
+func ScanUUID(src any) ([16]byte, error) {
+	switch x := src.(type) {
+	case string:
+		return ParseUUID(x)
+	case []byte:
+		if len(x) == 16 {
+			return [16]byte(x), nil
+		}
+		return ParseUUID(string(x))
+	}
+	return [16]byte{}, fmt.Errorf("unsupported type %T", src)
+}
+
+// UUIDString returns the canonical string form of u.
+func UUIDString(u [16]byte) string {
+	return string(AppendUUID(make([]byte, 0, UUIDLen), u))
+}
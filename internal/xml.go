@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// XSINamespace is the XML Schema instance namespace that defines the nil attribute.
+const XSINamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXMLText encodes text as the character data of start.
+func MarshalXMLText(e *xml.Encoder, start xml.StartElement, text []byte) error {
+	return e.EncodeElement(string(text), start)
+}
+
+// MarshalXMLNull encodes a null value as start, with an xsi:nil="true" attribute.
+func MarshalXMLNull(e *xml.Encoder, start xml.StartElement) error {
+	// the prefix is written literally, so that the output doesn't depend on
+	// how the encoder would name an automatic namespace prefix
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: XSINamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	return e.EncodeElement("", start)
+}
+
+// IsXMLNil reports whether start has an xsi:nil attribute set to true.
+// The "xsi" prefix is accepted even if it was not declared.
+func IsXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local != "nil" || (attr.Name.Space != XSINamespace && attr.Name.Space != "xsi") {
+			continue
+		}
+		b, err := strconv.ParseBool(attr.Value)
+		return err == nil && b
+	}
+	return false
+}
+
+// DecodeXMLText reads the character data of start, consuming the element.
+// It returns nil for elements marked with xsi:nil, and a non-nil empty slice for empty elements.
+func DecodeXMLText(d *xml.Decoder, start xml.StartElement) ([]byte, error) {
+	if IsXMLNil(start) {
+		return nil, d.Skip()
+	}
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
+
+// XMLAttrText returns the attribute text of ptr's value the same way encoding/xml does for struct fields:
+// using xml.MarshalerAttr or encoding.TextMarshaler if implemented, otherwise formatting basic kinds.
+// It reports false if the attribute should be omitted.
+func XMLAttrText(ptr any, name xml.Name) (string, bool, error) {
+	switch x := ptr.(type) {
+	case xml.MarshalerAttr:
+		attr, err := x.MarshalXMLAttr(name)
+		return attr.Value, attr.Name.Local != "", err
+	case encoding.TextMarshaler:
+		text, err := x.MarshalText()
+		return string(text), true, err
+	}
+	rv := reflect.ValueOf(ptr).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), true, nil
+	}
+	return "", false, fmt.Errorf("unsupported type %T", rv.Interface())
+}
+
+// UnmarshalXMLAttrText decodes attr into ptr the same way encoding/xml does for struct fields:
+// using xml.UnmarshalerAttr or encoding.TextUnmarshaler if implemented, otherwise parsing basic kinds.
+func UnmarshalXMLAttrText(ptr any, attr xml.Attr) error {
+	switch x := ptr.(type) {
+	case xml.UnmarshalerAttr:
+		return x.UnmarshalXMLAttr(attr)
+	case encoding.TextUnmarshaler:
+		return x.UnmarshalText([]byte(attr.Value))
+	}
+	rv := reflect.ValueOf(ptr).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(attr.Value)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(attr.Value, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(attr.Value, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(attr.Value, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(n)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(attr.Value)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+		return nil
+	}
+	return fmt.Errorf("unsupported type %T", rv.Interface())
+}
@@ -0,0 +1,195 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// JSON is a nullable raw JSON document, such as a JSON or JSONB column.
+//
+// SQL NULL and the JSON literal null are distinct: scanning SQL NULL produces a null JSON (Valid is false),
+// while scanning the text "null" produces a valid JSON holding the literal null.
+// When decoding from JSON, the literal null produces a null JSON,
+// and both null and the literal null will marshal to null.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool // Valid is true if JSON is not NULL
+}
+
+// NewJSON creates a new JSON.
+func NewJSON(b []byte, valid bool) JSON {
+	return JSON{
+		JSON:  b,
+		Valid: valid,
+	}
+}
+
+// JSONFrom creates a new JSON that will always be valid.
+// It does not copy b or check that it is valid JSON.
+func JSONFrom(b []byte) JSON {
+	return NewJSON(b, true)
+}
+
+// JSONFromPtr creates a new JSON that will be null if b is nil.
+func JSONFromPtr(b *json.RawMessage) JSON {
+	if b == nil {
+		return NewJSON(nil, false)
+	}
+	return NewJSON(*b, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (j JSON) ValueOrZero() json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (j JSON) ValueOr(v json.RawMessage) json.RawMessage {
+	if !j.Valid {
+		return v
+	}
+	return j.JSON
+}
+
+// Decode unmarshals this JSON's document into v, using encoding/json.
+// A null JSON is decoded as the literal null, which sets pointers, maps, and slices to nil
+// and leaves other values unchanged.
+func (j JSON) Decode(v any) error {
+	if err := json.Unmarshal(j.document(), v); err != nil {
+		return fmt.Errorf("null: couldn't decode JSON: %w", err)
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts []byte and string values, and copies the data.
+// It does not check that the data is valid JSON.
+func (j *JSON) Scan(src any) error {
+	switch x := src.(type) {
+	case nil:
+		j.JSON, j.Valid = nil, false
+		return nil
+	case []byte:
+		j.JSON, j.Valid = bytes.Clone(x), true
+		return nil
+	case string:
+		j.JSON, j.Valid = json.RawMessage(x), true
+		return nil
+	}
+	j.JSON, j.Valid = nil, false
+	return fmt.Errorf("null: couldn't scan JSON: unsupported type %T", src)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the document as []byte, or nil if this JSON is null.
+func (j JSON) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	return []byte(j.JSON), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the document unchanged, or null if this JSON is null or empty.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return j.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this JSON to dst, as MarshalJSON does.
+// It will append null if this JSON is null or empty.
+func (j JSON) AppendJSON(dst []byte) ([]byte, error) {
+	return append(dst, j.document()...), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It copies the input document, and will unmarshal the literal null to a null JSON.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("null: couldn't unmarshal JSON: no data")
+	}
+	if string(data) == "null" {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	j.JSON, j.Valid = bytes.Clone(data), true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the document unchanged, or a blank string if this JSON is null.
+func (j JSON) MarshalText() ([]byte, error) {
+	return j.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this JSON is null.
+func (j JSON) AppendText(dst []byte) ([]byte, error) {
+	if !j.Valid {
+		return dst, nil
+	}
+	return append(dst, j.JSON...), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null JSON if the input is blank or "null".
+// It returns an error if the input is not valid JSON.
+func (j *JSON) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	if !json.Valid(text) {
+		return errors.New("null: couldn't unmarshal text: invalid JSON")
+	}
+	j.JSON, j.Valid = bytes.Clone(text), true
+	return nil
+}
+
+// SetValid changes this JSON's value and also sets it to be non-null.
+func (j *JSON) SetValid(v json.RawMessage) {
+	j.JSON = v
+	j.Valid = true
+}
+
+// Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return &j.JSON
+}
+
+// IsZero returns true for null JSONs.
+// A non-null JSON holding the literal null will not be considered zero.
+func (j JSON) IsZero() bool {
+	return !j.Valid
+}
+
+// Equal returns true if both JSONs hold byte-for-byte identical documents or are both null.
+func (j JSON) Equal(other JSON) bool {
+	return j.Valid == other.Valid && (!j.Valid || bytes.Equal(j.JSON, other.JSON))
+}
+
+// Compare returns an integer comparing two JSONs by their raw bytes, following the conventions of cmp.Compare.
+// Null is less than any non-null value. This gives a stable order for sorting,
+// but documents that are equivalent yet formatted differently are not considered equal.
+func (j JSON) Compare(other JSON) int {
+	return internal.CompareFunc([]byte(j.JSON), j.Valid, []byte(other.JSON), other.Valid, bytes.Compare)
+}
+
+// document returns the document, or the literal null if there isn't one.
+func (j JSON) document() []byte {
+	if !j.Valid || len(j.JSON) == 0 {
+		return []byte("null")
+	}
+	return j.JSON
+}
@@ -0,0 +1,167 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	jsonDocument = []byte(`{"a":[1,2,3],"b":null}`)
+)
+
+func TestJSONFrom(t *testing.T) {
+	assertJSON(t, JSONFrom(jsonDocument), "JSONFrom()")
+	msg := json.RawMessage(jsonDocument)
+	assertJSON(t, JSONFromPtr(&msg), "JSONFromPtr()")
+	assertNullJSON(t, JSONFromPtr(nil), "JSONFromPtr(nil)")
+
+	literal := JSONFrom([]byte("null"))
+	if !literal.Valid || literal.IsZero() {
+		t.Error("literal null should be valid")
+	}
+}
+
+func TestJSONScanValue(t *testing.T) {
+	src := bytes.Clone(jsonDocument)
+	var j JSON
+	err := j.Scan(src)
+	maybePanic(err)
+	assertJSON(t, j, "scanned []byte")
+	// drivers may reuse their buffers
+	src[0] = '['
+	assertJSON(t, j, "scanned []byte after modifying source")
+
+	err = j.Scan(string(jsonDocument))
+	maybePanic(err)
+	assertJSON(t, j, "scanned string")
+
+	var literal JSON
+	err = literal.Scan([]byte("null"))
+	maybePanic(err)
+	if !literal.Valid || string(literal.JSON) != "null" {
+		t.Errorf("scanning the literal null should be valid, got %#v", literal)
+	}
+
+	var null JSON
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullJSON(t, null, "scanned null")
+
+	var bad JSON
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error: scanning int64")
+	}
+
+	v, err := JSONFrom(jsonDocument).Value()
+	maybePanic(err)
+	if b, ok := v.([]byte); !ok || !bytes.Equal(b, jsonDocument) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = literal.Value()
+	maybePanic(err)
+	if b, ok := v.([]byte); !ok || string(b) != "null" {
+		t.Errorf("bad literal null Value(): %#v", v)
+	}
+	v, err = JSON{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null Value() should be nil, got %#v", v)
+	}
+}
+
+func TestMarshalJSONDocument(t *testing.T) {
+	type wrapper struct {
+		Doc JSON `json:"doc"`
+	}
+	data, err := json.Marshal(wrapper{JSONFrom(jsonDocument)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"doc":`+string(jsonDocument)+`}`, "non-empty json marshal")
+
+	data, err = json.Marshal(wrapper{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"doc":null}`, "null json marshal")
+
+	data, err = json.Marshal(wrapper{JSONFrom(nil)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"doc":null}`, "empty json marshal")
+
+	var w wrapper
+	err = json.Unmarshal([]byte(`{"doc":`+string(jsonDocument)+`}`), &w)
+	maybePanic(err)
+	assertJSON(t, w.Doc, "unmarshal json")
+
+	err = json.Unmarshal([]byte(`{"doc":null}`), &w)
+	maybePanic(err)
+	assertNullJSON(t, w.Doc, "unmarshal null json")
+
+	data, err = JSONFrom(jsonDocument).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, string(jsonDocument), "text marshal")
+
+	data, err = JSON{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var text JSON
+	err = text.UnmarshalText(jsonDocument)
+	maybePanic(err)
+	assertJSON(t, text, "unmarshal text")
+
+	if err := text.UnmarshalText([]byte("{")); err == nil {
+		t.Error("expected error: invalid JSON text")
+	}
+}
+
+func TestJSONDecode(t *testing.T) {
+	var target struct {
+		A []int
+		B *string
+	}
+	err := JSONFrom(jsonDocument).Decode(&target)
+	maybePanic(err)
+	if len(target.A) != 3 || target.B != nil {
+		t.Errorf("bad Decode(): %#v", target)
+	}
+
+	ptr := &target
+	err = JSON{}.Decode(&ptr)
+	maybePanic(err)
+	if ptr != nil {
+		t.Error("decoding null should set pointers to nil")
+	}
+
+	var n int
+	if err := JSONFrom(jsonDocument).Decode(&n); err == nil {
+		t.Error("expected error: decoding object into int")
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	if !JSONFrom(jsonDocument).Equal(JSONFrom(bytes.Clone(jsonDocument))) {
+		t.Error("identical documents should be equal")
+	}
+	if JSONFrom([]byte("null")).Equal(JSON{}) {
+		t.Error("the literal null should not equal null")
+	}
+	if !(JSON{}).Equal(NewJSON(jsonDocument, false)) {
+		t.Error("nulls should be equal")
+	}
+}
+
+func assertJSON(t *testing.T, j JSON, from string) {
+	t.Helper()
+	if !bytes.Equal(j.JSON, jsonDocument) {
+		t.Errorf("bad %v JSON: %s ≠ %s", from, j.JSON, jsonDocument)
+	}
+	if !j.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullJSON(t *testing.T, j JSON, from string) {
+	t.Helper()
+	if j.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
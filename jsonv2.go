@@ -0,0 +1,861 @@
+// go1.27: encoding/json/v2 and encoding/json/jsontext (json.MarshalerTo, jsontext.Encoder, ...)
+// first appear in the Go API in api/go1.27.txt, and vet rejects them in files built for older versions.
+//go:build goexperiment.jsonv2 && go1.27
+
+package null
+
+import (
+	"bytes"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// With GOEXPERIMENT=jsonv2, the types in this package also implement
+// json.MarshalerTo and json.UnmarshalerFrom from encoding/json/v2.
+// They stream values through jsontext without allocating where possible,
+// and respect json/v2 options: numbers are written as strings for StringifyNumbers
+// or a `string` tag, and times and durations are handed to json/v2 itself,
+// so they follow its options and format tags.
+// When called with encoding/json v1 semantics, they defer to MarshalJSON and UnmarshalJSON.
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this String is null.
+func (s String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !s.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return enc.WriteToken(jsontext.String(s.String))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input. Blank string input does not produce a null String.
+func (s *String) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		s.Valid = false
+		return nil
+	}
+	s.String, s.Valid = string(text), true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int is null.
+func (i Int) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalIntTo(enc, i.Int64)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Int) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int64, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalIntTo(enc, int64(i.Int32))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Int32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int32, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalIntTo(enc, int64(i.Int16))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Int16) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int16, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalIntTo(enc, int64(i.Int8))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Int8) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int8, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Byte is null.
+func (b Byte) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !b.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalUintTo(enc, uint64(b.Byte))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (b *Byte) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &b.Byte, &b.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalUintTo(enc, i.Uint64)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Uint) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint64, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalUintTo(enc, uint64(i.Uint32))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Uint32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint32, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalUintTo(enc, uint64(i.Uint16))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Uint16) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint16, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !i.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalUintTo(enc, uint64(i.Uint8))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (i *Uint8) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint8, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Float is null.
+func (f Float) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !f.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalFloatTo(enc, f.Float64, 64)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (f *Float) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalFloatFrom(dec, &f.Float64, &f.Valid, 64); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Float32 is null.
+func (f Float32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !f.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalFloatTo(enc, float64(f.Float32), 32)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (f *Float32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalFloatFrom(dec, &f.Float32, &f.Valid, 32); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Decimal is null, otherwise a number.
+func (d Decimal) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return d.marshalJSONTo(enc, false)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this DecimalString is null, otherwise a string.
+func (d DecimalString) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return d.marshalJSONTo(enc, true)
+}
+
+func (d Decimal) marshalJSONTo(enc *jsontext.Encoder, quote bool) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !d.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [64]byte
+	return internal.MarshalNumberTo(enc, d.appendText(buf[:0]), quote)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (d *Decimal) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadNumberFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		*d = Decimal{}
+		return nil
+	}
+	v, err := parseDecimal(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this BigInt is null, otherwise a number.
+func (b BigInt) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return b.marshalJSONTo(enc, false)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this BigIntString is null, otherwise a string.
+func (b BigIntString) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return b.marshalJSONTo(enc, true)
+}
+
+func (b BigInt) marshalJSONTo(enc *jsontext.Encoder, quote bool) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !b.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [64]byte
+	return internal.MarshalNumberTo(enc, internal.AppendBigInt(buf[:0], b.value()), quote)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+func (b *BigInt) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadNumberFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		b.Int, b.Valid = nil, false
+		return nil
+	}
+	i, err := internal.ParseBigInt(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	b.Int, b.Valid = i, true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !b.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return enc.WriteToken(jsontext.Bool(b.Bool))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports true, false, and null input.
+func (b *Bool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	switch tok.Kind() {
+	case 'n':
+		b.Valid = false
+		return nil
+	case 't', 'f':
+		b.Bool, b.Valid = tok.Bool(), true
+		return nil
+	}
+	return fmt.Errorf("null: couldn't unmarshal JSON: unexpected JSON %s, want boolean", tok.Kind())
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Time is null.
+// Otherwise it encodes the time as json/v2 would, following its options and format tags.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !t.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return jsonv2.MarshalEncode(enc, t.Time)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports null input, and otherwise decodes the time as json/v2 would, following its options and format tags.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if null, err := internal.ReadNullFrom(dec); null || err != nil {
+		t.Valid = false
+		return err
+	}
+	if err := jsonv2.UnmarshalDecode(dec, &t.Time); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	t.Valid = true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Date is null.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !d.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [len(internal.DateLayout)]byte
+	return internal.MarshalQuotedTo(enc, internal.AppendDate(buf[:0], d.Year, d.Month, d.Day))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		*d = Date{}
+		return nil
+	}
+	year, month, day, err := internal.ParseDate(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	*d = DateFrom(year, month, day)
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !t.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [len(timeOfDayLayout)]byte
+	return internal.MarshalQuotedTo(enc, t.appendText(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input.
+func (t *TimeOfDay) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		*t = TimeOfDay{}
+		return nil
+	}
+	v, err := parseTimeOfDay(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	*t = v
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Duration is null.
+// Otherwise it encodes the duration as json/v2 would, following options such as FormatDurationAsNano,
+// or as a string such as "1m30s" if they don't select a representation.
+func (d Duration) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !d.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return internal.MarshalDurationTo(enc, d.Duration)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the representation selected by json/v2 options, as well as strings such as "1m30s",
+// numbers of nanoseconds, and null input.
+func (d *Duration) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	val, err := dec.ReadValue()
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if val.Kind() == 'n' {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	v, err := internal.UnmarshalDurationFrom(val, dec.Options())
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	d.Duration, d.Valid = v, true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode the document unchanged, or null if this JSON is null.
+func (j JSON) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return enc.WriteValue(j.document())
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It keeps a copy of any JSON value. The literal null unmarshals to a null JSON.
+func (j *JSON) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	val, err := dec.ReadValue()
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if val.Kind() == 'n' {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	j.JSON, j.Valid = bytes.Clone(val), true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Bytes is null, otherwise a base64 string.
+func (b Bytes) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !b.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [64]byte
+	text, _ := internal.AppendBytes(buf[:0], b.Bytes, internal.BytesBase64)
+	return internal.MarshalQuotedTo(enc, text)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports base64 string and null input.
+func (b *Bytes) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	v, err := internal.DecodeBytes(text, internal.BytesBase64)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if v == nil {
+		v = []byte{}
+	}
+	b.Bytes, b.Valid = v, true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this UUID is null.
+func (u UUID) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !u.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [internal.UUIDLen]byte
+	return internal.MarshalQuotedTo(enc, internal.AppendUUID(buf[:0], u.UUID))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input.
+func (u *UUID) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	v, err := internal.ParseUUID(string(text))
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	u.UUID, u.Valid = v, true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Addr is null.
+func (a Addr) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !a.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [64]byte
+	return internal.MarshalQuotedTo(enc, a.Addr.AppendTo(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input.
+func (a *Addr) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return nil
+	}
+	return a.UnmarshalText(text)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !p.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [64]byte
+	return internal.MarshalQuotedTo(enc, p.Prefix.AppendTo(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input.
+func (p *Prefix) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return nil
+	}
+	return p.UnmarshalText(text)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this AddrPort is null.
+func (ap AddrPort) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !ap.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	var buf [64]byte
+	return internal.MarshalQuotedTo(enc, ap.AddrPort.AppendTo(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input.
+func (ap *AddrPort) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		ap.AddrPort, ap.Valid = netip.AddrPort{}, false
+		return nil
+	}
+	return ap.UnmarshalText(text)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Value is null, otherwise T as json/v2 would.
+func (t Value[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !t.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return jsonv2.MarshalEncode(enc, t.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports null input, and otherwise decodes T as json/v2 would.
+func (t *Value[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if null, err := internal.ReadNullFrom(dec); null || err != nil {
+		t.Valid = false
+		return err
+	}
+	if err := jsonv2.UnmarshalDecode(dec, &t.V); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	t.Valid = true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this JSONValue is null, otherwise T as json/v2 would.
+func (t JSONValue[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !t.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return jsonv2.MarshalEncode(enc, t.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports null input, and otherwise decodes T as json/v2 would.
+func (t *JSONValue[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if null, err := internal.ReadNullFrom(dec); null || err != nil {
+		t.Valid = false
+		return err
+	}
+	if err := jsonv2.UnmarshalDecode(dec, &t.V); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	t.Valid = true
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Optional is null or unset, otherwise T as json/v2 would.
+// Use the omitzero tag option to leave out unset Optionals.
+func (o Optional[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	if !o.Valid {
+		return internal.MarshalNullTo(enc)
+	}
+	return jsonv2.MarshalEncode(enc, o.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// Because it is only called for fields that appear in the input,
+// it marks this Optional as present, even if the input is null.
+func (o *Optional[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	o.Present = true
+	if null, err := internal.ReadNullFrom(dec); null || err != nil {
+		var zero T
+		o.V, o.Valid = zero, false
+		return err
+	}
+	if err := jsonv2.UnmarshalDecode(dec, &o.V); err != nil {
+		o.Valid = false
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	o.Valid = true
+	return nil
+}
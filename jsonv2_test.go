@@ -0,0 +1,242 @@
+// go1.27: encoding/json/v2 and encoding/json/jsontext (json.MarshalerTo, jsontext.Encoder, ...)
+// first appear in the Go API in api/go1.27.txt, and vet rejects them in files built for older versions.
+//go:build goexperiment.jsonv2 && go1.27
+
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"io"
+	"math"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// jsonTypes is every type in this package, which should all support json/v2.
+var jsonTypes = []any{
+	String{}, Int{}, Int32{}, Int16{}, Int8{}, Byte{}, Uint{}, Uint32{}, Uint16{}, Uint8{},
+	Float{}, Float32{}, Decimal{}, BigInt{}, Bool{}, Time{}, Date{}, TimeOfDay{}, Duration{},
+	JSON{}, Bytes{}, UUID{}, Addr{}, Prefix{}, AddrPort{},
+	Value[int]{}, JSONValue[int]{}, Optional[int]{},
+}
+
+func TestJSONv2Interfaces(t *testing.T) {
+	for _, v := range jsonTypes {
+		if _, ok := v.(jsonv2.MarshalerTo); !ok {
+			t.Errorf("%T doesn't implement json.MarshalerTo", v)
+		}
+		if _, ok := reflect.New(reflect.TypeOf(v)).Interface().(jsonv2.UnmarshalerFrom); !ok {
+			t.Errorf("%T doesn't implement json.UnmarshalerFrom", v)
+		}
+	}
+}
+
+type jsonv2Record struct {
+	ID     Int           `json:"id"`
+	Small  Uint8         `json:"small"`
+	Name   String        `json:"name"`
+	Score  Float32       `json:"score"`
+	Price  Decimal       `json:"price"`
+	Big    BigInt        `json:"big"`
+	Active Bool          `json:"active"`
+	Born   Date          `json:"born"`
+	Seen   Time          `json:"seen"`
+	Wait   Duration      `json:"wait"`
+	Doc    JSON          `json:"doc"`
+	Raw    Bytes         `json:"raw"`
+	UUID   UUID          `json:"uuid"`
+	IP     Addr          `json:"ip"`
+	Tags   Value[[]int]  `json:"tags"`
+	Rank   Optional[int] `json:"rank,omitzero"`
+}
+
+func TestJSONv2RoundTrip(t *testing.T) {
+	in := jsonv2Record{
+		ID:     IntFrom(1),
+		Small:  Uint8From(255),
+		Name:   StringFrom("<test>"),
+		Score:  Float32From(0.1),
+		Price:  DecimalFrom(big.NewInt(150), 2),
+		Big:    BigIntFrom(big.NewInt(-2)),
+		Active: BoolFrom(false),
+		Born:   DateFrom(2000, time.January, 2),
+		Seen:   TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)),
+		Wait:   DurationFrom(90 * time.Second),
+		Doc:    JSONFrom([]byte(`{"a":1}`)),
+		Raw:    BytesFrom([]byte("hi")),
+		UUID:   UUIDFrom([16]byte{15: 1}),
+		IP:     AddrFrom(netip.MustParseAddr("192.0.2.1")),
+		Tags:   ValueFrom([]int{1, 2}),
+		Rank:   OptionalFrom(3),
+	}
+	data, err := jsonv2.Marshal(in)
+	maybePanic(err)
+	want := `{"id":1,"small":255,"name":"<test>","score":0.1,"price":1.50,"big":-2,"active":false,` +
+		`"born":"2000-01-02","seen":"2012-12-21T21:21:21Z","wait":"1m30s","doc":{"a":1},"raw":"aGk=",` +
+		`"uuid":"00000000-0000-0000-0000-000000000001","ip":"192.0.2.1","tags":[1,2],"rank":3}`
+	assertJSONEquals(t, data, want, "json/v2 marshal")
+
+	var out jsonv2Record
+	err = jsonv2.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.ID.Equal(in.ID) || !out.Small.Equal(in.Small) || !out.Name.Equal(in.Name) ||
+		!out.Score.Equal(in.Score) || !out.Price.Equal(in.Price) || !out.Big.Equal(in.Big) ||
+		!out.Active.Equal(in.Active) || !out.Born.Equal(in.Born) || !out.Seen.Equal(in.Seen) ||
+		!out.Wait.Equal(in.Wait) || !bytes.Equal(out.Doc.JSON, in.Doc.JSON) || !out.Raw.Equal(in.Raw) ||
+		!out.UUID.Equal(in.UUID) || !out.IP.Equal(in.IP) || len(out.Tags.V) != 2 || out.Rank != in.Rank {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+}
+
+func TestJSONv2Null(t *testing.T) {
+	data, err := jsonv2.Marshal(jsonv2Record{})
+	maybePanic(err)
+	want := `{"id":null,"small":null,"name":null,"score":null,"price":null,"big":null,"active":null,` +
+		`"born":null,"seen":null,"wait":null,"doc":null,"raw":null,"uuid":null,"ip":null,"tags":null}`
+	assertJSONEquals(t, data, want, "json/v2 null marshal")
+
+	out := jsonv2Record{ID: IntFrom(1), Name: StringFrom("x"), Seen: TimeFrom(time.Now()), Tags: ValueFrom([]int{1})}
+	err = jsonv2.Unmarshal([]byte(want), &out)
+	maybePanic(err)
+	if out.ID.Valid || out.Name.Valid || out.Seen.Valid || out.Tags.Valid {
+		t.Errorf("null should unmarshal as null: %+v", out)
+	}
+
+	var rank struct {
+		Rank Optional[int] `json:"rank"`
+	}
+	err = jsonv2.Unmarshal([]byte(`{"rank":null}`), &rank)
+	maybePanic(err)
+	if !rank.Rank.Present || rank.Rank.Valid {
+		t.Errorf("null Optional should be present and null: %+v", rank.Rank)
+	}
+}
+
+func TestJSONv2StringifyNumbers(t *testing.T) {
+	v := struct {
+		A Int     `json:"a"`
+		B Float   `json:"b"`
+		C Decimal `json:"c"`
+		D Int     `json:"d,string"`
+		E Int     `json:"e"`
+	}{A: IntFrom(1), B: FloatFrom(1.5), C: DecimalFrom(big.NewInt(20), 1), D: IntFrom(3)}
+
+	data, err := jsonv2.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"a":1,"b":1.5,"c":2.0,"d":"3","e":null}`, "string tag")
+
+	data, err = jsonv2.Marshal(v, jsonv2.StringifyNumbers(true))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"a":"1","b":"1.5","c":"2.0","d":"3","e":null}`, "StringifyNumbers")
+
+	v.A, v.D = Int{}, Int{}
+	err = jsonv2.Unmarshal(data, &v)
+	maybePanic(err)
+	if v.A != IntFrom(1) || v.D != IntFrom(3) || !v.C.Equal(DecimalFromInt(2)) {
+		t.Errorf("bad unmarshal of number strings: %+v", v)
+	}
+
+	data, err = jsonv2.Marshal(DecimalString{DecimalFrom(big.NewInt(20), 1)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2.0"`, "DecimalString")
+	data, err = jsonv2.Marshal(BigIntString{BigIntFrom(big.NewInt(-2))})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"-2"`, "BigIntString")
+}
+
+func TestJSONv2MapKeys(t *testing.T) {
+	in := map[Int]string{IntFrom(1): "a"}
+	data, err := jsonv2.Marshal(in)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"1":"a"}`, "map key")
+
+	var out map[Int]string
+	err = jsonv2.Unmarshal(data, &out)
+	maybePanic(err)
+	if out[IntFrom(1)] != "a" {
+		t.Errorf("bad map: %v", out)
+	}
+}
+
+func TestJSONv2Durations(t *testing.T) {
+	// json/v2 has no default for durations, so they use strings as they do with encoding/json
+	data, err := jsonv2.Marshal(DurationFrom(1500 * time.Millisecond))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1.5s"`, "duration marshal")
+
+	var d Duration
+	err = jsonv2.Unmarshal([]byte(`1000`), &d)
+	maybePanic(err)
+	if d != DurationFrom(time.Microsecond) {
+		t.Error("bad nanoseconds:", d)
+	}
+
+	// options that select a representation are followed
+	data, err = jsonv2.Marshal(DurationFrom(1500*time.Millisecond), json.FormatDurationAsNano(true))
+	maybePanic(err)
+	assertJSONEquals(t, data, `1500000000`, "FormatDurationAsNano marshal")
+
+	err = jsonv2.Unmarshal([]byte(`"1h"`), &d, json.FormatDurationAsNano(true))
+	maybePanic(err)
+	if d != DurationFrom(time.Hour) {
+		t.Error("bad string with FormatDurationAsNano:", d)
+	}
+}
+
+func TestJSONv2Errors(t *testing.T) {
+	var v struct {
+		I Int      `json:"i"`
+		U Uint8    `json:"u"`
+		F Float    `json:"f"`
+		S String   `json:"s"`
+		B Bool     `json:"b"`
+		D Date     `json:"d"`
+		W Duration `json:"w"`
+	}
+	for _, in := range []string{
+		`{"i":"x"}`, `{"i":1.5}`, `{"i":true}`, `{"u":256}`, `{"u":-1}`, `{"f":"x"}`,
+		`{"s":1}`, `{"b":0}`, `{"d":"2000-13-01"}`, `{"w":"1 fortnight"}`,
+	} {
+		if err := jsonv2.Unmarshal([]byte(in), &v); err == nil {
+			t.Errorf("expected error: %s", in)
+		}
+	}
+	if _, err := jsonv2.Marshal(FloatFrom(math.Inf(1))); err == nil {
+		t.Error("expected error for Inf, got nil")
+	}
+}
+
+func TestJSONv2Legacy(t *testing.T) {
+	// encoding/json keeps using MarshalJSON, ignoring the string tag
+	v := struct {
+		A Int      `json:"a,string"`
+		W Duration `json:"w"`
+	}{A: IntFrom(1), W: DurationFrom(time.Second)}
+	data, err := json.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"a":1,"w":"1s"}`, "encoding/json marshal")
+}
+
+func TestJSONv2Allocs(t *testing.T) {
+	enc := jsontext.NewEncoder(io.Discard)
+	for _, v := range []jsonv2.MarshalerTo{
+		IntFrom(123456), Uint8From(1), FloatFrom(1.5), Float32From(0.1), StringFrom("hello"),
+		BoolFrom(true), Int{}, DateFrom(2000, 1, 2), UUIDFrom([16]byte{1}),
+	} {
+		if n := testing.AllocsPerRun(100, func() { maybePanic(v.MarshalJSONTo(enc)) }); n != 0 {
+			t.Errorf("%T: %v allocations, want 0", v, n)
+		}
+	}
+
+	dec := jsontext.NewDecoder(bytes.NewReader(bytes.Repeat([]byte(`12345 "678" null `), 100)))
+	var i Int
+	if n := testing.AllocsPerRun(100, func() { maybePanic(i.UnmarshalJSONFrom(dec)) }); n != 0 {
+		t.Errorf("Int: %v allocations, want 0", n)
+	}
+}
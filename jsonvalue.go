@@ -0,0 +1,251 @@
+//go:build go1.22
+
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// JSONValue represents a value that may be null and is stored in SQL as JSON,
+// such as a struct kept in a JSONB column.
+// Value encodes T as JSON and Scan decodes it, using encoding/json.
+// A column holding the JSON literal null is scanned as null.
+// Otherwise it behaves like Value.
+type JSONValue[T any] struct {
+	sql.Null[T]
+}
+
+// NewJSONValue creates a new JSONValue.
+func NewJSONValue[T any](t T, valid bool) JSONValue[T] {
+	return JSONValue[T]{
+		Null: sql.Null[T]{
+			V:     t,
+			Valid: valid,
+		},
+	}
+}
+
+// JSONValueFrom creates a new JSONValue that will always be valid.
+func JSONValueFrom[T any](t T) JSONValue[T] {
+	return NewJSONValue(t, true)
+}
+
+// JSONValueFromPtr creates a new JSONValue that will be null if t is nil.
+func JSONValueFromPtr[T any](t *T) JSONValue[T] {
+	if t == nil {
+		var zero T
+		return NewJSONValue(zero, false)
+	}
+	return NewJSONValue(*t, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t JSONValue[T]) ValueOrZero() T {
+	if !t.Valid {
+		var zero T
+		return zero
+	}
+	return t.V
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (t JSONValue[T]) ValueOr(v T) T {
+	if !t.Valid {
+		return v
+	}
+	return t.V
+}
+
+// Scan implements the sql.Scanner interface.
+// It decodes JSON from []byte or string values into a new T.
+func (t *JSONValue[T]) Scan(src any) error {
+	var zero T
+	t.V, t.Valid = zero, false
+
+	var data []byte
+	switch x := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = x
+	case string:
+		data = []byte(x)
+	default:
+		return fmt.Errorf("null: couldn't scan JSONValue: unsupported type %T", src)
+	}
+
+	if string(data) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(data, &t.V); err != nil {
+		t.V = zero
+		return fmt.Errorf("null: couldn't scan JSONValue: %w", err)
+	}
+	t.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the JSON encoding of the inner value as []byte, or nil if null.
+func (t JSONValue[T]) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	data, err := json.Marshal(t.V)
+	if err != nil {
+		return nil, fmt.Errorf("null: couldn't encode JSONValue: %w", err)
+	}
+	return data, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this value is null.
+func (t JSONValue[T]) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this JSONValue to dst, as MarshalJSON does.
+// It will append null if this value is null.
+func (t JSONValue[T]) AppendJSON(dst []byte) ([]byte, error) {
+	if !t.Valid {
+		return append(dst, "null"...), nil
+	}
+	data, err := json.Marshal(t.V)
+	return append(dst, data...), err
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports null input, and otherwise decodes into T.
+func (t *JSONValue[T]) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		t.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &t.V); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	t.Valid = true
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null with an xsi:nil="true" attribute, otherwise T as encoding/xml would.
+func (t JSONValue[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !t.Valid {
+		return internal.MarshalXMLNull(e, start)
+	}
+	return e.EncodeElement(t.V, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Elements with an xsi:nil="true" attribute decode to null.
+func (t *JSONValue[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	valid, err := unmarshalXMLValue(d, start, &t.V)
+	t.Valid = valid
+	return err
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this JSONValue is null.
+func (t JSONValue[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !t.Valid {
+		return xml.Attr{}, nil
+	}
+	return marshalXMLAttrValue(name, &t.V)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *JSONValue[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if err := unmarshalXMLAttrValue(attr, &t.V); err != nil {
+		t.Valid = false
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this JSONValue is null,
+// otherwise T using its MarshalBinary method if it has one, or as JSON.
+func (t JSONValue[T]) MarshalBinary() ([]byte, error) {
+	b := internal.AppendBinaryHeader(nil, t.Valid)
+	if !t.Valid {
+		return b, nil
+	}
+	return internal.AppendBinaryValue(b, t.V)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes T using its UnmarshalBinary method if it has one, or as JSON.
+func (t *JSONValue[T]) UnmarshalBinary(data []byte) error {
+	var zero T
+	t.V, t.Valid = zero, false
+	payload, valid, err := internal.ReadBinary(data)
+	if err == nil && valid {
+		err = internal.ParseBinaryValue(payload, &t.V)
+	}
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal binary: %w", err)
+	}
+	t.Valid = valid
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (t JSONValue[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (t *JSONValue[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// SetValid changes this JSONValue's value and sets it to be non-null.
+func (t *JSONValue[T]) SetValid(v T) {
+	t.V = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this JSONValue's value, or a nil pointer if this JSONValue is null.
+func (t JSONValue[T]) Ptr() *T {
+	if !t.Valid {
+		return nil
+	}
+	return &t.V
+}
+
+// IsZero returns true for invalid JSONValues.
+// A non-null JSONValue with a zero value will not be considered zero.
+func (t JSONValue[T]) IsZero() bool {
+	return !t.Valid
+}
+
+// CompareFunc returns an integer comparing two JSONValues, following the conventions of cmp.Compare.
+// Null is less than any non-null value, and non-null values are compared with cmp.
+func (t JSONValue[T]) CompareFunc(other JSONValue[T], cmp func(a, b T) int) int {
+	return internal.CompareFunc(t.V, t.Valid, other.V, other.Valid, cmp)
+}
+
+// Equal returns true if both JSONValues are null, or if both are valid and encode to the same JSON,
+// as they would be stored in SQL. Values that fail to encode are not equal to anything.
+func (t JSONValue[T]) Equal(other JSONValue[T]) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	a, err := json.Marshal(t.V)
+	if err != nil {
+		return false
+	}
+	b, err := json.Marshal(other.V)
+	return err == nil && bytes.Equal(a, b)
+}
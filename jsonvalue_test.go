@@ -0,0 +1,127 @@
+//go:build go1.22
+
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type jsonValueTest struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+func TestJSONValue(t *testing.T) {
+	good := jsonValueTest{Name: "hello", Tags: []string{"a", "b"}}
+	goodJSON := `{"name":"hello","tags":["a","b"]}`
+
+	v, err := JSONValueFrom(good).Value()
+	maybePanic(err)
+	if b, ok := v.([]byte); !ok || string(b) != goodJSON {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	v, err = JSONValue[jsonValueTest]{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null Value() should be nil, got %#v", v)
+	}
+
+	for _, src := range []any{[]byte(goodJSON), goodJSON} {
+		var jv JSONValue[jsonValueTest]
+		err := jv.Scan(src)
+		maybePanic(err)
+		if !jv.Valid || !reflect.DeepEqual(jv.V, good) {
+			t.Errorf("bad scan of %T: %#v", src, jv)
+		}
+	}
+
+	// scanning replaces the previous value instead of merging
+	jv := JSONValueFrom(good)
+	err = jv.Scan(`{"name":"bye"}`)
+	maybePanic(err)
+	if jv.V.Tags != nil {
+		t.Errorf("scan should replace the previous value, got %#v", jv.V)
+	}
+
+	for _, src := range []any{nil, "null"} {
+		jv := JSONValueFrom(good)
+		err := jv.Scan(src)
+		maybePanic(err)
+		if jv.Valid || !reflect.DeepEqual(jv.V, jsonValueTest{}) {
+			t.Errorf("scanning %v should be null, got %#v", src, jv)
+		}
+	}
+
+	var bad JSONValue[jsonValueTest]
+	if err := bad.Scan(`[1, 2, 3]`); err == nil {
+		t.Error("expected error: scanning array into struct")
+	}
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error: scanning int64")
+	}
+	if bad.Valid {
+		t.Error("bad scan should be null")
+	}
+
+	if _, err := JSONValueFrom(func() {}).Value(); err == nil {
+		t.Error("expected error: encoding func")
+	}
+}
+
+func TestJSONValueEqual(t *testing.T) {
+	table := []struct {
+		a, b JSONValue[map[string]int]
+		want bool
+	}{
+		{JSONValueFrom(map[string]int{"a": 1, "b": 2}), JSONValueFrom(map[string]int{"b": 2, "a": 1}), true},
+		{JSONValueFrom(map[string]int{"a": 1}), JSONValueFrom(map[string]int{"a": 2}), false},
+		{JSONValue[map[string]int]{}, NewJSONValue(map[string]int{"a": 1}, false), true},
+		{JSONValue[map[string]int]{}, JSONValueFrom[map[string]int](nil), false},
+		{JSONValueFrom(map[string]int{}), JSONValueFrom[map[string]int](nil), false},
+	}
+	for _, test := range table {
+		if got := test.a.Equal(test.b); got != test.want {
+			t.Errorf("%#v.Equal(%#v): got %v, want %v", test.a, test.b, got, test.want)
+		}
+		if got := test.b.Equal(test.a); got != test.want {
+			t.Errorf("%#v.Equal(%#v): got %v, want %v", test.b, test.a, got, test.want)
+		}
+	}
+
+	bad := JSONValueFrom(func() {})
+	if bad.Equal(bad) {
+		t.Error("values that can't be encoded shouldn't be equal")
+	}
+}
+
+func TestJSONValueConstructors(t *testing.T) {
+	n := 42
+	if jv := JSONValueFromPtr(&n); !jv.Valid || jv.V != 42 {
+		t.Errorf("bad JSONValueFromPtr(): %#v", jv)
+	}
+	if jv := JSONValueFromPtr[int](nil); jv.Valid || jv.Ptr() != nil {
+		t.Errorf("bad JSONValueFromPtr(nil): %#v", jv)
+	}
+	if v := NewJSONValue(1, false).ValueOr(2); v != 2 {
+		t.Errorf("bad ValueOr(): %d", v)
+	}
+	if v := NewJSONValue(1, false).ValueOrZero(); v != 0 {
+		t.Errorf("bad ValueOrZero(): %d", v)
+	}
+
+	type wrapper struct {
+		Data JSONValue[map[string]int] `json:"data"`
+	}
+	data, err := json.Marshal(wrapper{JSONValueFrom(map[string]int{"a": 1})})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"data":{"a":1}}`, "json marshal")
+
+	var w wrapper
+	err = json.Unmarshal([]byte(`{"data":null}`), &w)
+	maybePanic(err)
+	if !w.Data.IsZero() {
+		t.Error("null json should be zero")
+	}
+}
@@ -0,0 +1,186 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/netip"
+	"testing"
+)
+
+func TestAddr(t *testing.T) {
+	want := netip.MustParseAddr("192.0.2.1")
+	for _, src := range []any{"192.0.2.1", []byte("192.0.2.1"), "192.0.2.1/32"} {
+		var a Addr
+		err := a.Scan(src)
+		maybePanic(err)
+		if !a.Valid || a.Addr != want {
+			t.Errorf("bad scan of %#v: %#v", src, a)
+		}
+	}
+	for _, src := range []any{"192.0.2.0/24", "nope", int64(1)} {
+		var a Addr
+		if err := a.Scan(src); err == nil {
+			t.Errorf("expected error scanning %#v", src)
+		}
+		if a.Valid {
+			t.Errorf("bad scan of %#v should be null", src)
+		}
+	}
+
+	v6 := AddrFrom(netip.MustParseAddr("2001:db8::1"))
+	v, err := v6.Value()
+	maybePanic(err)
+	if v != "2001:db8::1" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	data, err := json.Marshal(v6)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2001:db8::1"`, "json marshal")
+
+	var a Addr
+	err = json.Unmarshal(data, &a)
+	maybePanic(err)
+	if !a.Equal(v6) {
+		t.Errorf("bad unmarshal: %#v", a)
+	}
+	if err := json.Unmarshal([]byte(`"nope"`), &a); err == nil {
+		t.Error("expected error: bad address")
+	}
+
+	// INET text from Scan can be echoed back through JSON
+	err = json.Unmarshal([]byte(`"192.0.2.1/32"`), &a)
+	maybePanic(err)
+	if !a.Valid || a.Addr != want {
+		t.Errorf("bad unmarshal of INET text: %#v", a)
+	}
+	if err := a.UnmarshalText([]byte("192.0.2.0/24")); err == nil || a.Valid {
+		t.Errorf("expected error and null for a network: %#v", a)
+	}
+
+	testNetipNull(t, Addr{}, &Addr{})
+	if AddrFromPtr(nil).Valid || !AddrFromPtr(&want).Valid {
+		t.Error("bad AddrFromPtr")
+	}
+	if !AddrFrom(netip.Addr{}).Valid {
+		t.Error("AddrFrom(netip.Addr{}) should be valid")
+	}
+	assertCompare(t, AddrFrom(want).Compare(v6), -1, "IPv4 vs IPv6")
+	assertCompare(t, Addr{}.Compare(AddrFrom(netip.Addr{})), -1, "null vs zero")
+}
+
+func TestPrefix(t *testing.T) {
+	want := netip.MustParsePrefix("192.0.2.0/24")
+	for _, src := range []any{"192.0.2.0/24", []byte("192.0.2.0/24")} {
+		var p Prefix
+		err := p.Scan(src)
+		maybePanic(err)
+		if !p.Valid || p.Prefix != want {
+			t.Errorf("bad scan of %#v: %#v", src, p)
+		}
+	}
+
+	// INET host values have no prefix length
+	var host Prefix
+	err := host.Scan("2001:db8::1")
+	maybePanic(err)
+	if host.Prefix != netip.MustParsePrefix("2001:db8::1/128") {
+		t.Errorf("bad host scan: %v", host.Prefix)
+	}
+
+	var bad Prefix
+	if err := bad.Scan("192.0.2.0/33"); err == nil {
+		t.Error("expected error: bad prefix length")
+	}
+
+	v, err := PrefixFrom(want).Value()
+	maybePanic(err)
+	if v != "192.0.2.0/24" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	data, err := PrefixFrom(want).MarshalJSON()
+	maybePanic(err)
+	assertJSONEquals(t, data, `"192.0.2.0/24"`, "json marshal")
+
+	var text Prefix
+	err = text.UnmarshalText([]byte("2001:db8::1"))
+	maybePanic(err)
+	if !text.Equal(host) {
+		t.Errorf("bad unmarshal of a bare address: %v", text.Prefix)
+	}
+
+	testNetipNull(t, Prefix{}, &Prefix{})
+	assertCompare(t, PrefixFrom(want).Compare(PrefixFrom(netip.MustParsePrefix("192.0.2.0/25"))), -1, "/24 vs /25")
+	assertCompare(t, PrefixFrom(want).Compare(PrefixFrom(netip.MustParsePrefix("10.0.0.0/24"))), 1, "192 vs 10")
+	assertCompare(t, PrefixFrom(want).Compare(host), -1, "IPv4 vs IPv6")
+}
+
+func TestAddrPort(t *testing.T) {
+	want := netip.MustParseAddrPort("[2001:db8::1]:443")
+	for _, src := range []any{"[2001:db8::1]:443", []byte("[2001:db8::1]:443")} {
+		var ap AddrPort
+		err := ap.Scan(src)
+		maybePanic(err)
+		if !ap.Valid || ap.AddrPort != want {
+			t.Errorf("bad scan of %#v: %#v", src, ap)
+		}
+	}
+	var bad AddrPort
+	if err := bad.Scan("2001:db8::1"); err == nil {
+		t.Error("expected error: missing port")
+	}
+
+	v, err := AddrPortFrom(want).Value()
+	maybePanic(err)
+	if v != "[2001:db8::1]:443" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+	text, err := AddrPortFrom(want).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, text, "[2001:db8::1]:443", "text marshal")
+
+	testNetipNull(t, AddrPort{}, &AddrPort{})
+	assertCompare(t, AddrPortFrom(netip.MustParseAddrPort("192.0.2.1:80")).Compare(AddrPortFrom(netip.MustParseAddrPort("192.0.2.1:443"))), -1, "port 80 vs 443")
+}
+
+type netipNullable interface {
+	MarshalJSON() ([]byte, error)
+	MarshalText() ([]byte, error)
+	Value() (driver.Value, error)
+	IsZero() bool
+}
+
+func testNetipNull[N netipNullable, PN interface {
+	*N
+	netipNullable
+	Scan(any) error
+	UnmarshalJSON([]byte) error
+	UnmarshalText([]byte) error
+}](t *testing.T, null N, scratch PN) {
+	t.Helper()
+	data, err := null.MarshalJSON()
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+	if v, _ := null.Value(); v != nil {
+		t.Errorf("null Value() should be nil, got %#v", v)
+	}
+
+	err = scratch.Scan(nil)
+	maybePanic(err)
+	if !scratch.IsZero() {
+		t.Error("scanned nil should be null")
+	}
+	err = scratch.UnmarshalJSON(nullJSON)
+	maybePanic(err)
+	if !scratch.IsZero() {
+		t.Error("unmarshaled null json should be null")
+	}
+	err = scratch.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if !scratch.IsZero() {
+		t.Error("unmarshaled blank text should be null")
+	}
+}
@@ -0,0 +1,361 @@
+//go:build go1.22
+
+package null
+
+import (
+	"cmp"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// State describes whether an Optional was left unset, explicitly set to null, or set to a value.
+type State uint8
+
+const (
+	// StateUnset means the Optional was never given a value, such as a JSON field that was omitted.
+	StateUnset State = iota
+	// StateNull means the Optional was explicitly set to null.
+	StateNull
+	// StateSet means the Optional holds a valid value.
+	StateSet
+)
+
+// String returns the name of this State.
+func (s State) String() string {
+	switch s {
+	case StateUnset:
+		return "unset"
+	case StateNull:
+		return "null"
+	case StateSet:
+		return "set"
+	}
+	return fmt.Sprintf("State(%d)", uint8(s))
+}
+
+// Optional is a tri-state value that tells apart a value that is absent (unset),
+// explicitly null, or set to a value.
+// This is useful for PATCH-style APIs, where an omitted JSON field should be left alone
+// but an explicit null should clear it.
+//
+// Optional will be marked as present whenever it is unmarshaled, scanned, or given a value with SetValid.
+// Its IsZero method reports whether it is unset, so `,omitzero` will omit unset fields
+// but still encode explicit nulls.
+type Optional[T any] struct {
+	sql.Null[T]
+	// Present is true if this Optional has been set, either to a value or to null.
+	Present bool
+}
+
+// OptionalString is an Optional string.
+type OptionalString = Optional[string]
+
+// OptionalInt is an Optional int64.
+type OptionalInt = Optional[int64]
+
+// OptionalTime is an Optional time.Time.
+type OptionalTime = Optional[time.Time]
+
+// NewOptional creates a new Optional that is present, and will be null if valid is false.
+func NewOptional[T any](t T, valid bool) Optional[T] {
+	return Optional[T]{
+		Null: sql.Null[T]{
+			V:     t,
+			Valid: valid,
+		},
+		Present: true,
+	}
+}
+
+// OptionalFrom creates a new Optional that is set to t.
+func OptionalFrom[T any](t T) Optional[T] {
+	return NewOptional(t, true)
+}
+
+// OptionalFromPtr creates a new Optional that is present, and will be null if t is nil.
+func OptionalFromPtr[T any](t *T) Optional[T] {
+	if t == nil {
+		var zero T
+		return NewOptional(zero, false)
+	}
+	return NewOptional(*t, true)
+}
+
+// OptionalNull creates a new Optional that is explicitly null.
+func OptionalNull[T any]() Optional[T] {
+	var zero T
+	return NewOptional(zero, false)
+}
+
+// State returns whether this Optional is unset, null, or set.
+func (o Optional[T]) State() State {
+	switch {
+	case !o.Present:
+		return StateUnset
+	case !o.Valid:
+		return StateNull
+	}
+	return StateSet
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (o Optional[T]) ValueOrZero() T {
+	if !o.Valid {
+		var zero T
+		return zero
+	}
+	return o.V
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (o Optional[T]) ValueOr(v T) T {
+	if !o.Valid {
+		return v
+	}
+	return o.V
+}
+
+// Scan implements the sql.Scanner interface.
+// A scanned Optional is always present.
+func (o *Optional[T]) Scan(src any) error {
+	o.Present = true
+	return o.Null.Scan(src)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Optional is null or unset.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return o.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Optional to dst, as MarshalJSON does.
+// It will append null if this Optional is null or unset.
+func (o Optional[T]) AppendJSON(dst []byte) ([]byte, error) {
+	if !o.Valid {
+		return append(dst, "null"...), nil
+	}
+	data, err := json.Marshal(o.V)
+	return append(dst, data...), err
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Because it is only called for fields that appear in the input,
+// it marks this Optional as present, even if the input is null.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Present = true
+	if len(data) > 0 && data[0] == 'n' {
+		var zero T
+		o.V = zero
+		o.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &o.V); err != nil {
+		o.Valid = false
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	o.Valid = true
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode nothing if this Optional is unset,
+// null with an xsi:nil="true" attribute, and otherwise T as encoding/xml would.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	switch {
+	case !o.Present:
+		return nil
+	case !o.Valid:
+		return internal.MarshalXMLNull(e, start)
+	}
+	return e.EncodeElement(o.V, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// It marks this Optional as present. Elements with an xsi:nil="true" attribute decode to null.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.Present = true
+	valid, err := unmarshalXMLValue(d, start, &o.V)
+	o.Valid = valid
+	return err
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Optional is null or unset.
+func (o Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.Valid {
+		return xml.Attr{}, nil
+	}
+	return marshalXMLAttrValue(name, &o.V)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// It marks this Optional as present.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.Present = true
+	if err := unmarshalXMLAttrValue(attr, &o.V); err != nil {
+		o.Valid = false
+		return err
+	}
+	o.Valid = true
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Optional is null or unset,
+// otherwise T using its MarshalBinary method if it has one, or as JSON.
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	switch {
+	case !o.Present:
+		return internal.AppendBinaryUnset(nil), nil
+	case !o.Valid:
+		return internal.AppendBinaryHeader(nil, false), nil
+	}
+	return internal.AppendBinaryValue(internal.AppendBinaryHeader(nil, true), o.V)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes T using its UnmarshalBinary method if it has one, or as JSON.
+// Unlike UnmarshalJSON, it restores whether this Optional was present.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	*o = Optional[T]{}
+	payload, valid, present, err := internal.ReadOptionalBinary(data)
+	if err == nil && valid {
+		err = internal.ParseBinaryValue(payload, &o.V)
+	}
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal binary: %w", err)
+	}
+	o.Valid, o.Present = valid, present
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (o Optional[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (o *Optional[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// SetValid changes this Optional's value and sets it to be present and non-null.
+func (o *Optional[T]) SetValid(v T) {
+	o.V = v
+	o.Valid = true
+	o.Present = true
+}
+
+// SetNull sets this Optional to be present and null.
+func (o *Optional[T]) SetNull() {
+	var zero T
+	o.V = zero
+	o.Valid = false
+	o.Present = true
+}
+
+// Unset sets this Optional to be unset.
+func (o *Optional[T]) Unset() {
+	*o = Optional[T]{}
+}
+
+// Ptr returns a pointer to this Optional's value, or a nil pointer if this Optional is null or unset.
+func (o Optional[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	return &o.V
+}
+
+// IsZero returns true for unset Optionals, for use with `,omitzero`.
+// A null Optional will not be considered zero.
+func (o Optional[T]) IsZero() bool {
+	return !o.Present
+}
+
+// CompareFunc returns an integer comparing two Optionals, following the conventions of cmp.Compare.
+// Unset is less than null, which is less than any non-null value, and non-null values are compared with compare.
+func (o Optional[T]) CompareFunc(other Optional[T], compare func(a, b T) int) int {
+	if c := cmp.Compare(o.State(), other.State()); c != 0 || o.State() != StateSet {
+		return c
+	}
+	return compare(o.V, other.V)
+}
+
+func (o Optional[T]) isPresent() bool {
+	return o.Present
+}
+
+type presenter interface {
+	driver.Valuer
+	isPresent() bool
+}
+
+var presenterType = reflect.TypeFor[presenter]()
+
+// UpdateSet returns the column names and values of every present Optional field in v,
+// which must be a struct or a pointer to one.
+// Unset fields are skipped, so the result can be used to build the SET clause of an SQL UPDATE statement.
+// Null fields are included and will be written as NULL.
+//
+// Column names are taken from the field's `db` struct tag, falling back to the field name.
+// Fields tagged `db:"-"` are ignored. Embedded structs are searched recursively.
+func UpdateSet(v any) (columns []string, values []any, err error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil, nil, fmt.Errorf("null: UpdateSet: want struct, got nil")
+	}
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil, fmt.Errorf("null: UpdateSet: nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("null: UpdateSet: want struct, got %s", rv.Type())
+	}
+	columns, values = appendUpdateSet(columns, values, rv)
+	return columns, values, nil
+}
+
+func appendUpdateSet(columns []string, values []any, rv reflect.Value) ([]string, []any) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, _, _ := strings.Cut(field.Tag.Get("db"), ",")
+		if tag == "-" {
+			continue
+		}
+		fv := rv.Field(i)
+		if field.Type.Implements(presenterType) {
+			if !fv.CanInterface() {
+				continue
+			}
+			opt := fv.Interface().(presenter)
+			if !opt.isPresent() {
+				continue
+			}
+			name := tag
+			if name == "" {
+				name = field.Name
+			}
+			columns = append(columns, name)
+			values = append(values, opt)
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			columns, values = appendUpdateSet(columns, values, fv)
+		}
+	}
+	return columns, values
+}
@@ -0,0 +1,144 @@
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type patchUser struct {
+	Name     OptionalString `json:"name,omitzero" db:"name"`
+	Age      OptionalInt    `json:"age,omitzero" db:"age"`
+	Birthday OptionalTime   `json:"birthday,omitzero"`
+	Ignored  OptionalString `json:"ignored,omitzero" db:"-"`
+	patchMeta
+}
+
+type patchMeta struct {
+	Note Optional[string] `json:"note,omitzero" db:"note"`
+}
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	var user patchUser
+	err := json.Unmarshal([]byte(`{"name": "bob", "age": null}`), &user)
+	maybePanic(err)
+
+	if user.Name.State() != StateSet || user.Name.V != "bob" {
+		t.Errorf("bad name: %#v (%v)", user.Name, user.Name.State())
+	}
+	if user.Age.State() != StateNull {
+		t.Errorf("bad age: %#v (%v)", user.Age, user.Age.State())
+	}
+	if user.Birthday.State() != StateUnset {
+		t.Errorf("bad birthday: %#v (%v)", user.Birthday, user.Birthday.State())
+	}
+
+	var bad Optional[int]
+	err = json.Unmarshal([]byte(`"hello"`), &bad)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestOptionalMarshalJSON(t *testing.T) {
+	user := patchUser{
+		Name: OptionalFrom("alice"),
+		Age:  OptionalNull[int64](),
+	}
+	data, err := json.Marshal(user)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"alice","age":null}`, "omitzero optional")
+
+	data, err = json.Marshal(OptionalString{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "unset optional")
+}
+
+func TestOptionalState(t *testing.T) {
+	var opt Optional[int]
+	if opt.State() != StateUnset || !opt.IsZero() {
+		t.Errorf("zero value should be unset: %#v", opt)
+	}
+	if opt.Ptr() != nil || opt.ValueOr(5) != 5 || opt.ValueOrZero() != 0 {
+		t.Errorf("unset should behave as null: %#v", opt)
+	}
+
+	opt.SetNull()
+	if opt.State() != StateNull || opt.IsZero() {
+		t.Errorf("should be null: %#v", opt)
+	}
+
+	opt.SetValid(42)
+	if opt.State() != StateSet || *opt.Ptr() != 42 || opt.ValueOr(5) != 42 {
+		t.Errorf("should be set: %#v", opt)
+	}
+
+	opt.Unset()
+	if opt.State() != StateUnset {
+		t.Errorf("should be unset: %#v", opt)
+	}
+
+	n := 42
+	if OptionalFromPtr(&n).State() != StateSet {
+		t.Error("OptionalFromPtr(&n) should be set")
+	}
+	if OptionalFromPtr[int](nil).State() != StateNull {
+		t.Error("OptionalFromPtr(nil) should be null")
+	}
+}
+
+func TestOptionalScan(t *testing.T) {
+	var opt OptionalInt
+	err := opt.Scan(int64(123))
+	maybePanic(err)
+	if opt.State() != StateSet || opt.V != 123 {
+		t.Errorf("bad scanned value: %#v", opt)
+	}
+
+	var null OptionalInt
+	err = null.Scan(nil)
+	maybePanic(err)
+	if null.State() != StateNull {
+		t.Errorf("bad scanned null: %#v", null)
+	}
+
+	v, err := OptionalInt{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("unset Value() should be nil, got %#v", v)
+	}
+}
+
+func TestUpdateSet(t *testing.T) {
+	birthday := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+	user := patchUser{
+		Age:      OptionalNull[int64](),
+		Birthday: OptionalFrom(birthday),
+		Ignored:  OptionalFrom("ignore me"),
+		patchMeta: patchMeta{
+			Note: OptionalFrom("hi"),
+		},
+	}
+	cols, vals, err := UpdateSet(&user)
+	maybePanic(err)
+
+	wantCols := []string{"age", "Birthday", "note"}
+	if !reflect.DeepEqual(cols, wantCols) {
+		t.Errorf("bad columns: %v ≠ %v", cols, wantCols)
+	}
+	wantVals := []any{user.Age, user.Birthday, user.Note}
+	if !reflect.DeepEqual(vals, wantVals) {
+		t.Errorf("bad values: %v ≠ %v", vals, wantVals)
+	}
+
+	if _, _, err := UpdateSet(123); err == nil {
+		t.Error("expected error for non-struct")
+	}
+	if _, _, err := UpdateSet((*patchUser)(nil)); err == nil {
+		t.Error("expected error for nil pointer")
+	}
+	if _, _, err := UpdateSet(nil); err == nil {
+		t.Error("expected error for nil")
+	}
+}
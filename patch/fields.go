@@ -0,0 +1,183 @@
+package patch
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type field struct {
+	name  string
+	index []int
+	typ   reflect.Type
+}
+
+type fields struct {
+	list   []field
+	byName map[string]int
+}
+
+// lookup finds the field for a JSON key.
+// Like encoding/json, it prefers an exact match but falls back to a case-insensitive one.
+func (fs fields) lookup(key string) (field, bool) {
+	if i, ok := fs.byName[key]; ok {
+		return fs.list[i], true
+	}
+	for _, f := range fs.list {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+var fieldCache sync.Map // map[reflect.Type]fields
+
+func cachedFields(t reflect.Type) fields {
+	if fs, ok := fieldCache.Load(t); ok {
+		return fs.(fields)
+	}
+	fs := collectFields(t)
+	actual, _ := fieldCache.LoadOrStore(t, fs)
+	return actual.(fields)
+}
+
+// collectFields gathers the JSON-visible fields of struct type t, following encoding/json's rules.
+// Fields of untagged embedded structs and struct pointers are promoted.
+// When several fields share a name, the shallowest one wins, then the one with a JSON tag;
+// if that still leaves more than one, the name is ambiguous and all of them are dropped.
+func collectFields(t reflect.Type) fields {
+	type candidate struct {
+		field
+		depth  int
+		tagged bool
+	}
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+	}
+
+	var all []candidate
+	next := []embedded{{typ: t}}
+	var count, nextCount map[reflect.Type]int
+	visited := make(map[reflect.Type]bool)
+	for depth := 0; len(next) > 0; depth++ {
+		current := next
+		next = nil
+		count, nextCount = nextCount, make(map[reflect.Type]int)
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+			visited[e.typ] = true
+			for i := 0; i < e.typ.NumField(); i++ {
+				sf := e.typ.Field(i)
+				ft := sf.Type
+				if sf.Anonymous {
+					if ft.Kind() == reflect.Pointer {
+						ft = ft.Elem()
+					}
+					if !sf.IsExported() && ft.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !sf.IsExported() {
+					continue
+				}
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, _, _ := strings.Cut(tag, ",")
+				index := append(append([]int(nil), e.index...), i)
+
+				if !sf.Anonymous || name != "" || ft.Kind() != reflect.Struct {
+					c := candidate{
+						field:  field{name: name, index: index, typ: sf.Type},
+						depth:  depth,
+						tagged: name != "",
+					}
+					if !c.tagged {
+						c.name = sf.Name
+					}
+					all = append(all, c)
+					if count[e.typ] > 1 {
+						// embedded more than once at this depth, so its fields are ambiguous
+						all = append(all, c)
+					}
+					continue
+				}
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, embedded{typ: ft, index: index})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(all, func(a, b candidate) int {
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.depth, b.depth); c != 0 {
+			return c
+		}
+		if a.tagged != b.tagged {
+			if a.tagged {
+				return -1
+			}
+			return 1
+		}
+		return slices.Compare(a.index, b.index)
+	})
+	var list []field
+	for i := 0; i < len(all); {
+		j := i + 1
+		for j < len(all) && all[j].name == all[i].name {
+			j++
+		}
+		if j-i == 1 || all[i].depth != all[i+1].depth || all[i].tagged != all[i+1].tagged {
+			list = append(list, all[i].field)
+		}
+		i = j
+	}
+	slices.SortFunc(list, func(a, b field) int {
+		return slices.Compare(a.index, b.index)
+	})
+
+	fs := fields{list: list, byName: make(map[string]int, len(list))}
+	for i, f := range list {
+		fs.byName[f.name] = i
+	}
+	return fs
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex,
+// but allocates nil embedded struct pointers on the way to the field.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("can't set embedded pointer to unexported struct %v", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+// fieldOrZero is like reflect.Value.FieldByIndex,
+// but returns the zero value for a field behind a nil embedded struct pointer.
+func fieldOrZero(v reflect.Value, f field) reflect.Value {
+	fv, err := v.FieldByIndexErr(f.index)
+	if err != nil {
+		return reflect.Zero(f.typ)
+	}
+	return fv
+}
@@ -0,0 +1,320 @@
+// Package patch implements JSON Merge Patch (RFC 7396) for structs built from null and zero types.
+//
+// Apply merges a patch document into a struct: keys missing from the patch are left alone,
+// and an explicit null sets the field to null using the field's own UnmarshalJSON,
+// so null.* and zero.* types end up with Valid set to false.
+// Diff does the reverse, producing a patch from the difference between two structs
+// using each field's Equal method when it has one.
+package patch
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var (
+	unmarshalerType     = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	marshalerType       = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// Apply applies the JSON Merge Patch document doc to target, which must be a non-nil pointer to a struct.
+// Fields are matched to keys the same way encoding/json does, and unknown keys are ignored.
+// Nested plain structs, pointers to them, and maps with string keys are merged recursively.
+// Everything else, including all null and zero types, is replaced with the patch's value.
+func Apply(target any, doc []byte) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("patch: target must be a non-nil pointer to a struct, got %T", target)
+	}
+	doc = bytes.TrimSpace(doc)
+	if !isObject(doc) {
+		return errors.New("patch: document must be a JSON object")
+	}
+	if err := applyObject(rv.Elem(), doc); err != nil {
+		return fmt.Errorf("patch: %w", err)
+	}
+	return nil
+}
+
+func applyObject(rv reflect.Value, doc []byte) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(doc, &obj); err != nil {
+		return err
+	}
+	fields := cachedFields(rv.Type())
+	for key, raw := range obj {
+		f, ok := fields.lookup(key)
+		if !ok {
+			continue
+		}
+		fv, err := fieldByIndex(rv, f.index)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+		if err := applyValue(fv, raw); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+func applyValue(fv reflect.Value, raw json.RawMessage) error {
+	if isNull(raw) {
+		if u, ok := fv.Addr().Interface().(json.Unmarshaler); ok {
+			return u.UnmarshalJSON(raw)
+		}
+		fv.SetZero()
+		return nil
+	}
+	if isObject(raw) && mergeable(fv.Type()) {
+		switch fv.Kind() {
+		case reflect.Pointer:
+			if fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			return applyValue(fv.Elem(), raw)
+		case reflect.Struct:
+			return applyObject(fv, raw)
+		case reflect.Map:
+			return applyMap(fv, raw)
+		}
+	}
+	return json.Unmarshal(raw, fv.Addr().Interface())
+}
+
+func applyMap(mv reflect.Value, doc []byte) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(doc, &obj); err != nil {
+		return err
+	}
+	if mv.IsNil() {
+		mv.Set(reflect.MakeMap(mv.Type()))
+	}
+	mt := mv.Type()
+	for key, raw := range obj {
+		k := reflect.ValueOf(key).Convert(mt.Key())
+		if isNull(raw) {
+			mv.SetMapIndex(k, reflect.Value{})
+			continue
+		}
+		elem := reflect.New(mt.Elem()).Elem()
+		if old := mv.MapIndex(k); old.IsValid() {
+			elem.Set(old)
+		}
+		if err := applyValue(elem, raw); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		mv.SetMapIndex(k, elem)
+	}
+	return nil
+}
+
+// Diff returns a JSON Merge Patch document that, when applied to from, makes it equal to to.
+// from and to must be structs of the same type, or pointers to them.
+// Fields are compared with their Equal method if they have one, so null values compare equal
+// and zero types consider null and zero to be the same.
+// Fields without an Equal method are compared with reflect.DeepEqual,
+// except for nested plain structs and maps, which are diffed recursively.
+// Diff returns "{}" if there are no differences.
+//
+// A merge patch can't set a map value to null, because Apply deletes keys whose value is null.
+// Diff returns an error if a map value in to encodes as null, such as a null null.Int,
+// instead of producing a patch that would delete it.
+func Diff(from, to any) ([]byte, error) {
+	a, b := reflect.ValueOf(from), reflect.ValueOf(to)
+	for _, v := range []*reflect.Value{&a, &b} {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return nil, errors.New("patch: Diff of nil pointer")
+			}
+			*v = v.Elem()
+		}
+	}
+	if a.Type() != b.Type() || a.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("patch: can't diff %T and %T, want two structs of the same type", from, to)
+	}
+	patch, err := diffStruct(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("patch: %w", err)
+	}
+	if patch == nil {
+		return []byte("{}"), nil
+	}
+	return patch, nil
+}
+
+// diffStruct returns a patch object for the changed fields of a and b, or nil if nothing changed.
+func diffStruct(a, b reflect.Value) ([]byte, error) {
+	var obj object
+	for _, f := range cachedFields(a.Type()).list {
+		patch, changed, err := diffValue(fieldOrZero(a, f), fieldOrZero(b, f))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.name, err)
+		}
+		if changed {
+			obj.add(f.name, patch)
+		}
+	}
+	return obj.bytes(), nil
+}
+
+func diffValue(a, b reflect.Value) (patch []byte, changed bool, err error) {
+	if eq, ok := equal(a, b); ok {
+		if eq {
+			return nil, false, nil
+		}
+		patch, err = json.Marshal(b.Interface())
+		return patch, true, err
+	}
+
+	if mergeable(a.Type()) {
+		switch a.Kind() {
+		case reflect.Pointer:
+			switch {
+			case a.IsNil() && b.IsNil():
+				return nil, false, nil
+			case a.IsNil() || b.IsNil():
+				patch, err = json.Marshal(b.Interface())
+				return patch, true, err
+			}
+			return diffValue(a.Elem(), b.Elem())
+		case reflect.Struct:
+			patch, err = diffStruct(a, b)
+			return patch, patch != nil, err
+		case reflect.Map:
+			if b.IsNil() && !a.IsNil() {
+				return []byte("null"), true, nil
+			}
+			patch, err = diffMap(a, b)
+			return patch, patch != nil, err
+		}
+	}
+
+	if reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return nil, false, nil
+	}
+	patch, err = json.Marshal(b.Interface())
+	return patch, true, err
+}
+
+var errNullMapValue = errors.New("map value is null, which a merge patch would apply as a deletion")
+
+func diffMap(a, b reflect.Value) ([]byte, error) {
+	keys := make([]reflect.Value, 0, a.Len()+b.Len())
+	keys = append(keys, a.MapKeys()...)
+	for _, k := range b.MapKeys() {
+		if !a.MapIndex(k).IsValid() {
+			keys = append(keys, k)
+		}
+	}
+	slices.SortFunc(keys, func(x, y reflect.Value) int {
+		return strings.Compare(x.String(), y.String())
+	})
+
+	var obj object
+	for _, k := range keys {
+		av, bv := a.MapIndex(k), b.MapIndex(k)
+		switch {
+		case !bv.IsValid():
+			obj.add(k.String(), []byte("null"))
+		case !av.IsValid():
+			patch, err := json.Marshal(bv.Interface())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k.String(), err)
+			}
+			if isNull(patch) {
+				return nil, fmt.Errorf("%s: %w", k.String(), errNullMapValue)
+			}
+			obj.add(k.String(), patch)
+		default:
+			patch, changed, err := diffValue(av, bv)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k.String(), err)
+			}
+			if changed && isNull(patch) {
+				return nil, fmt.Errorf("%s: %w", k.String(), errNullMapValue)
+			}
+			if changed {
+				obj.add(k.String(), patch)
+			}
+		}
+	}
+	return obj.bytes(), nil
+}
+
+// equal calls a.Equal(b) if a has a method with the signature func(T) bool.
+func equal(a, b reflect.Value) (eq bool, ok bool) {
+	m := a.MethodByName("Equal")
+	if !m.IsValid() {
+		return false, false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 1 || mt.In(0) != a.Type() || mt.NumOut() != 1 || mt.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	return m.Call([]reflect.Value{b})[0].Bool(), true
+}
+
+// mergeable reports whether values of type t are merged key by key
+// instead of being replaced wholesale.
+func mergeable(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+	}
+	pt := reflect.PointerTo(t)
+	if pt.Implements(unmarshalerType) || pt.Implements(textUnmarshalerType) ||
+		pt.Implements(marshalerType) || pt.Implements(textMarshalerType) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return true
+	case reflect.Map:
+		return t.Key().Kind() == reflect.String
+	}
+	return false
+}
+
+func isNull(raw []byte) bool {
+	return string(raw) == "null"
+}
+
+func isObject(raw []byte) bool {
+	return len(raw) > 0 && raw[0] == '{'
+}
+
+// object builds a JSON object, keeping keys in insertion order.
+type object struct {
+	buf bytes.Buffer
+}
+
+func (o *object) add(key string, value []byte) {
+	if o.buf.Len() == 0 {
+		o.buf.WriteByte('{')
+	} else {
+		o.buf.WriteByte(',')
+	}
+	k, _ := json.Marshal(key)
+	o.buf.Write(k)
+	o.buf.WriteByte(':')
+	o.buf.Write(value)
+}
+
+func (o *object) bytes() []byte {
+	if o.buf.Len() == 0 {
+		return nil
+	}
+	o.buf.WriteByte('}')
+	return o.buf.Bytes()
+}
@@ -0,0 +1,288 @@
+package patch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/guregu/null/v6"
+	"github.com/guregu/null/v6/zero"
+)
+
+type address struct {
+	City null.String `json:"city"`
+	Zip  zero.String `json:"zip"`
+}
+
+type resource struct {
+	ID        int64             `json:"id"`
+	Name      null.String       `json:"name"`
+	Age       null.Int          `json:"age"`
+	Score     zero.Float        `json:"score"`
+	Admin     null.Bool         `json:"admin"`
+	UpdatedAt null.Time         `json:"updated_at"`
+	Home      address           `json:"home"`
+	Work      *address          `json:"work"`
+	Tags      []string          `json:"tags"`
+	Labels    map[string]string `json:"labels"`
+	Secret    string            `json:"-"`
+}
+
+func TestApply(t *testing.T) {
+	updated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	r := resource{
+		ID:     1,
+		Name:   null.StringFrom("bob"),
+		Age:    null.IntFrom(30),
+		Score:  zero.FloatFrom(1.5),
+		Admin:  null.BoolFrom(true),
+		Home:   address{City: null.StringFrom("Tokyo"), Zip: zero.StringFrom("100")},
+		Tags:   []string{"a"},
+		Labels: map[string]string{"keep": "1", "drop": "2"},
+		Secret: "shh",
+	}
+
+	doc := []byte(`{
+		"name": null,
+		"score": null,
+		"admin": false,
+		"updated_at": "2024-05-01T12:00:00Z",
+		"home": {"zip": null},
+		"work": {"city": "Osaka"},
+		"tags": null,
+		"labels": {"drop": null, "new": "3"},
+		"Secret": "ignored",
+		"unknown": 123
+	}`)
+	if err := Apply(&r, doc); err != nil {
+		t.Fatal(err)
+	}
+
+	want := resource{
+		ID:        1,
+		Name:      null.NewString("bob", false),
+		Age:       null.IntFrom(30),
+		Score:     zero.NewFloat(0, false),
+		Admin:     null.BoolFrom(false),
+		UpdatedAt: null.TimeFrom(updated),
+		Home:      address{City: null.StringFrom("Tokyo"), Zip: zero.NewString("100", false)},
+		Work:      &address{City: null.StringFrom("Osaka")},
+		Labels:    map[string]string{"keep": "1", "new": "3"},
+		Secret:    "shh",
+	}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("bad patch result.\n got: %#v\nwant: %#v", r, want)
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	var r resource
+	if err := Apply(r, []byte(`{}`)); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+	if err := Apply(&r, []byte(`[1, 2]`)); err == nil {
+		t.Error("expected error for non-object patch")
+	}
+	if err := Apply(&r, []byte(`{"age": "hello"}`)); err == nil {
+		t.Error("expected error for bad field value")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	from := resource{
+		ID:     1,
+		Name:   null.StringFrom("bob"),
+		Age:    null.IntFrom(30),
+		Home:   address{City: null.StringFrom("Tokyo")},
+		Labels: map[string]string{"keep": "1", "drop": "2"},
+	}
+	to := from
+	to.Name = null.String{}
+	to.Age = null.IntFrom(31)
+	to.Score = zero.NewFloat(0, true) // equal to null for zero.Float
+	to.Home.Zip = zero.StringFrom("100")
+	to.Work = &address{City: null.StringFrom("Osaka")}
+	to.Labels = map[string]string{"keep": "1", "new": "3"}
+
+	patch, err := Diff(from, &to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":null,"age":31,"home":{"zip":"100"},"work":{"city":"Osaka","zip":""},"labels":{"drop":null,"new":"3"}}`
+	if string(patch) != want {
+		t.Errorf("bad diff.\n got: %s\nwant: %s", patch, want)
+	}
+
+	// round trip
+	if err := Apply(&from, patch); err != nil {
+		t.Fatal(err)
+	}
+	again, err := Diff(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != "{}" {
+		t.Errorf("diff after applying patch should be empty, got: %s", again)
+	}
+}
+
+func TestDiffTime(t *testing.T) {
+	type event struct {
+		At null.Time `json:"at"`
+	}
+	utc := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	a := event{At: null.TimeFrom(utc)}
+	b := event{At: null.TimeFrom(utc.In(time.FixedZone("JST", 9*60*60)))}
+	patch, err := Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(patch) != "{}" {
+		t.Errorf("times in different locations should be equal, got: %s", patch)
+	}
+
+	b.At = null.TimeFrom(utc.Add(time.Hour))
+	patch, err = Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(patch, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["at"] != "2024-05-01T13:00:00Z" {
+		t.Errorf("bad time diff: %s", patch)
+	}
+}
+
+func TestDiffJSONValue(t *testing.T) {
+	type doc struct {
+		Meta null.JSONValue[map[string]any] `json:"meta"`
+	}
+	// equal as JSON, though not to reflect.DeepEqual
+	a := doc{Meta: null.JSONValueFrom(map[string]any{"n": 1})}
+	b := doc{Meta: null.JSONValueFrom(map[string]any{"n": 1.0})}
+	patch, err := Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(patch) != "{}" {
+		t.Errorf("values with the same JSON should be equal, got: %s", patch)
+	}
+
+	b.Meta.V["n"] = 2
+	patch, err = Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(patch) != `{"meta":{"n":2}}` {
+		t.Errorf("bad JSONValue diff: %s", patch)
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	if _, err := Diff(resource{}, address{}); err == nil {
+		t.Error("expected error for different types")
+	}
+	if _, err := Diff(1, 2); err == nil {
+		t.Error("expected error for non-structs")
+	}
+
+	// Apply would delete these keys instead of setting them to null
+	type counts struct {
+		Tags map[string]null.Int `json:"tags"`
+	}
+	from := counts{Tags: map[string]null.Int{"x": null.IntFrom(1)}}
+	if patch, err := Diff(from, counts{Tags: map[string]null.Int{"x": {}}}); err == nil {
+		t.Errorf("expected error for map value changed to null, got: %s", patch)
+	}
+	if patch, err := Diff(from, counts{Tags: map[string]null.Int{"x": null.IntFrom(1), "y": {}}}); err == nil {
+		t.Errorf("expected error for new null map value, got: %s", patch)
+	}
+	patch, err := Diff(from, counts{Tags: map[string]null.Int{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(patch) != `{"tags":{"x":null}}` {
+		t.Errorf("bad diff for deleted map key: %s", patch)
+	}
+}
+
+type Base struct {
+	ID      null.Int    `json:"id"`
+	Name    null.String // conflicts with Extra.Name
+	Created null.String `json:"created"`
+}
+
+type Extra struct {
+	Name null.String
+}
+
+type tagged struct {
+	Kind null.String `json:"Kind"`
+}
+
+type untagged struct {
+	Kind null.String
+}
+
+type page struct {
+	*Base
+	Extra
+	tagged
+	untagged
+	ID int64 `json:"id"` // shallower than Base.ID
+}
+
+func TestFields(t *testing.T) {
+	p := page{
+		Base:     &Base{ID: null.IntFrom(1), Name: null.StringFrom("a"), Created: null.StringFrom("now")},
+		Extra:    Extra{Name: null.StringFrom("b")},
+		tagged:   tagged{Kind: null.StringFrom("e")},
+		untagged: untagged{Kind: null.StringFrom("f")},
+		ID:       2,
+	}
+	var names []string
+	for _, f := range cachedFields(reflect.TypeFor[page]()).list {
+		names = append(names, f.name)
+	}
+	if want := []string{"created", "Kind", "id"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("bad fields. got: %v want: %v", names, want)
+	}
+
+	// same as encoding/json
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"created":"now","Kind":"e","id":2}`; string(b) != want {
+		t.Errorf("encoding/json disagrees. got: %s want: %s", b, want)
+	}
+}
+
+func TestEmbeddedPointer(t *testing.T) {
+	var p page
+	if err := Apply(&p, []byte(`{"created":"now","id":2,"Name":"ignored"}`)); err != nil {
+		t.Fatal(err)
+	}
+	want := page{Base: &Base{Created: null.StringFrom("now")}, ID: 2}
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("bad patch result.\n got: %#v\nwant: %#v", p, want)
+	}
+
+	patch, err := Diff(page{}, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"created":"now","id":2}`; string(patch) != want {
+		t.Errorf("bad diff.\n got: %s\nwant: %s", patch, want)
+	}
+	patch, err = Diff(p, page{ID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"created":null}`; string(patch) != want {
+		t.Errorf("bad diff to nil embedded pointer.\n got: %s\nwant: %s", patch, want)
+	}
+}
@@ -0,0 +1,179 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Prefix is a nullable netip.Prefix, such as a Postgres CIDR or INET column.
+// It will marshal to null if null, and to the prefix's text form otherwise.
+type Prefix struct {
+	Prefix netip.Prefix
+	Valid  bool // Valid is true if Prefix is not NULL
+}
+
+// NewPrefix creates a new Prefix.
+func NewPrefix(p netip.Prefix, valid bool) Prefix {
+	return Prefix{
+		Prefix: p,
+		Valid:  valid,
+	}
+}
+
+// PrefixFrom creates a new Prefix that will always be valid.
+func PrefixFrom(p netip.Prefix) Prefix {
+	return NewPrefix(p, true)
+}
+
+// PrefixFromPtr creates a new Prefix that will be null if p is nil.
+func PrefixFromPtr(p *netip.Prefix) Prefix {
+	if p == nil {
+		return NewPrefix(netip.Prefix{}, false)
+	}
+	return NewPrefix(*p, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero netip.Prefix.
+func (p Prefix) ValueOrZero() netip.Prefix {
+	if !p.Valid {
+		return netip.Prefix{}
+	}
+	return p.Prefix
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (p Prefix) ValueOr(v netip.Prefix) netip.Prefix {
+	if !p.Valid {
+		return v
+	}
+	return p.Prefix
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts string and []byte values in CIDR notation, such as "192.0.2.0/24" or "2001:db8::/32".
+// An address without a prefix length, as Postgres uses for INET host values,
+// produces a prefix containing only that address.
+func (p *Prefix) Scan(src any) error {
+	if src == nil {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return nil
+	}
+	str, err := internal.ScanString(src)
+	if err == nil {
+		p.Prefix, err = internal.ParsePrefix(str)
+	}
+	if err != nil {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return fmt.Errorf("null: couldn't scan Prefix: %w", err)
+	}
+	p.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the prefix's text form.
+func (p Prefix) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+	return p.Prefix.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (p *Prefix) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	return p.UnmarshalText([]byte(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Prefix if the input is blank or "null".
+// Like Scan, it accepts a bare address as a single-address prefix, such as "192.0.2.1" for "192.0.2.1/32".
+func (p *Prefix) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return nil
+	}
+	v, err := internal.ParsePrefix(str)
+	if err != nil {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	p.Prefix, p.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Prefix is null, otherwise netip.Prefix's text form.
+func (p Prefix) MarshalJSON() ([]byte, error) {
+	return p.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Prefix to dst, as MarshalJSON does.
+// It will append null if this Prefix is null.
+func (p Prefix) AppendJSON(dst []byte) ([]byte, error) {
+	if !p.Valid {
+		return append(dst, "null"...), nil
+	}
+	var buf [64]byte
+	return internal.AppendJSONString(dst, p.Prefix.AppendTo(buf[:0])), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Prefix is null, otherwise netip.Prefix's text form.
+func (p Prefix) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Prefix is null.
+func (p Prefix) AppendText(dst []byte) ([]byte, error) {
+	if !p.Valid {
+		return dst, nil
+	}
+	return p.Prefix.AppendTo(dst), nil
+}
+
+// SetValid changes this Prefix's value and also sets it to be non-null.
+func (p *Prefix) SetValid(v netip.Prefix) {
+	p.Prefix = v
+	p.Valid = true
+}
+
+// Ptr returns a pointer to this Prefix's value, or a nil pointer if this Prefix is null.
+func (p Prefix) Ptr() *netip.Prefix {
+	if !p.Valid {
+		return nil
+	}
+	return &p.Prefix
+}
+
+// IsZero returns true for null Prefixes.
+// A non-null Prefix with a zero value will not be considered zero.
+func (p Prefix) IsZero() bool {
+	return !p.Valid
+}
+
+// Equal returns true if both Prefixes have the same value or are both null.
+func (p Prefix) Equal(other Prefix) bool {
+	return p.Valid == other.Valid && (!p.Valid || p.Prefix == other.Prefix)
+}
+
+// Compare returns an integer comparing two Prefixes, following the conventions of cmp.Compare.
+// Prefixes are ordered by address family, then prefix length, then address.
+// Null is less than any non-null value.
+func (p Prefix) Compare(other Prefix) int {
+	return internal.CompareFunc(p.Prefix, p.Valid, other.Prefix, other.Valid, internal.ComparePrefix)
+}
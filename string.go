@@ -8,6 +8,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 // String is a nullable string. It supports SQL and JSON serialization.
@@ -63,7 +65,9 @@ func (s *String) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &s.String); err != nil {
+	if str, ok := internal.UnquoteSimpleJSON(data); ok {
+		s.String = string(str)
+	} else if err := json.Unmarshal(data, &s.String); err != nil {
 		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
 	}
 
@@ -74,19 +78,31 @@ func (s *String) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this String is null.
 func (s String) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this String to dst, as MarshalJSON does.
+// It will append null if this String is null.
+func (s String) AppendJSON(dst []byte) ([]byte, error) {
 	if !s.Valid {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
 	}
-	return json.Marshal(s.String)
+	return internal.AppendJSONString(dst, s.String), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string when this String is null.
 func (s String) MarshalText() ([]byte, error) {
+	return s.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this String is null.
+func (s String) AppendText(dst []byte) ([]byte, error) {
 	if !s.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return []byte(s.String), nil
+	return append(dst, s.String...), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -120,3 +136,9 @@ func (s String) IsZero() bool {
 func (s String) Equal(other String) bool {
 	return s.Valid == other.Valid && (!s.Valid || s.String == other.String)
 }
+
+// Compare returns an integer comparing two Strings, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (s String) Compare(other String) int {
+	return internal.Compare(s.String, s.Valid, other.String, other.Valid)
+}
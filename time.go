@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 // Time is a nullable time.Time. It supports SQL and JSON serialization.
@@ -64,10 +66,21 @@ func (t Time) ValueOr(v time.Time) time.Time {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
 func (t Time) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Time to dst, as MarshalJSON does.
+// It will append null if this Time is null.
+func (t Time) AppendJSON(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
+	}
+	n := len(dst)
+	dst, err := internal.AppendTime(append(dst, '"'), t.Time, "Time.MarshalJSON: ")
+	if err != nil {
+		return dst[:n], err
 	}
-	return t.Time.MarshalJSON()
+	return append(dst, '"'), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -78,8 +91,12 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &t.Time); err != nil {
-		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	// time.Time's UnmarshalJSON handles strings without escapes on its own,
+	// so only go through encoding/json for anything else or to report an error
+	if _, ok := internal.UnquoteSimpleJSON(data); !ok || t.Time.UnmarshalJSON(data) != nil {
+		if err := json.Unmarshal(data, &t.Time); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+		}
 	}
 
 	t.Valid = true
@@ -89,10 +106,16 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 // MarshalText implements encoding.TextMarshaler.
 // It returns an empty string if invalid, otherwise time.Time's MarshalText.
 func (t Time) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Time is null.
+func (t Time) AppendText(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte{}, nil
+		return dst, nil
 	}
-	return t.Time.MarshalText()
+	return internal.AppendTime(dst, t.Time, "Time.MarshalText: ")
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -139,6 +162,13 @@ func (t Time) Equal(other Time) bool {
 	return t.Valid == other.Valid && (!t.Valid || t.Time.Equal(other.Time))
 }
 
+// Compare returns an integer comparing two Times, following the conventions of cmp.Compare.
+// Times are compared with time.Time's Compare method, so locations are ignored.
+// Null is less than any non-null Time.
+func (t Time) Compare(other Time) int {
+	return internal.CompareFunc(t.Time, t.Valid, other.Time, other.Valid, time.Time.Compare)
+}
+
 // ExactEqual returns true if both Time objects are equal or both null.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
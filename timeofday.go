@@ -0,0 +1,296 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// timeOfDayLayout is the layout used to encode TimeOfDay values.
+// Trailing zeros in the fractional seconds are omitted, and parsing accepts any precision.
+const timeOfDayLayout = "15:04:05.999999999"
+
+// endOfDay is how Postgres writes the end of the day, which is after every other time of day.
+const endOfDay = "24:00:00"
+
+// TimeOfDay is a nullable time of day with nanosecond precision, such as a SQL TIME.
+// It has no date or location.
+// Like a Postgres TIME, it may be "24:00:00", the end of the day, which is different from midnight at its start.
+// It will marshal to null if null, and to a "15:04:05.999999999" string otherwise.
+type TimeOfDay struct {
+	Hour       int  // Hour of the day, from 0 to 23, or 24 for the end of the day.
+	Minute     int  // Minute of the hour, from 0 to 59.
+	Second     int  // Second of the minute, from 0 to 59.
+	Nanosecond int  // Nanosecond of the second, from 0 to 999999999.
+	Valid      bool // Valid is true if TimeOfDay is not NULL
+}
+
+// NewTimeOfDay creates a new TimeOfDay.
+func NewTimeOfDay(hour, minute, second, nanosecond int, valid bool) TimeOfDay {
+	return TimeOfDay{
+		Hour:       hour,
+		Minute:     minute,
+		Second:     second,
+		Nanosecond: nanosecond,
+		Valid:      valid,
+	}
+}
+
+// TimeOfDayFrom creates a new TimeOfDay that will always be valid.
+func TimeOfDayFrom(hour, minute, second, nanosecond int) TimeOfDay {
+	return NewTimeOfDay(hour, minute, second, nanosecond, true)
+}
+
+// TimeOfDayOf returns the time of day of t, in t's location. It will always be valid.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDayFrom(hour, minute, second, t.Nanosecond())
+}
+
+// TimeOfDayFromPtr creates a new TimeOfDay from d, the time elapsed since midnight.
+// It will be null if d is nil.
+func TimeOfDayFromPtr(d *time.Duration) TimeOfDay {
+	if d == nil {
+		return TimeOfDay{}
+	}
+	var t TimeOfDay
+	t.SetValid(*d)
+	return t
+}
+
+// ParseTimeOfDay parses a time of day in the format "15:04:05", with optional fractional seconds.
+// It also accepts "24:00:00" for the end of the day.
+// It returns a null TimeOfDay if s is blank.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	var t TimeOfDay
+	err := t.UnmarshalText([]byte(s))
+	return t, err
+}
+
+// ValueOrZero returns the time elapsed since midnight if valid, otherwise zero.
+func (t TimeOfDay) ValueOrZero() time.Duration {
+	if !t.Valid {
+		return 0
+	}
+	return t.sinceMidnight()
+}
+
+// ValueOr returns the time elapsed since midnight if valid, otherwise v.
+func (t TimeOfDay) ValueOr(v time.Duration) time.Duration {
+	if !t.Valid {
+		return v
+	}
+	return t.sinceMidnight()
+}
+
+// On returns the time at this time of day on the given date in loc.
+// It returns the zero time if either t or date is null.
+func (t TimeOfDay) On(date Date, loc *time.Location) time.Time {
+	if !t.Valid || !date.Valid {
+		return time.Time{}
+	}
+	return time.Date(date.Year, date.Month, date.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, loc)
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts "15:04:05" strings with optional fractional seconds, including "24:00:00",
+// as well as time.Time values, using the clock time in the time's location and ignoring the date.
+func (t *TimeOfDay) Scan(src any) error {
+	switch x := src.(type) {
+	case nil:
+		*t = TimeOfDay{}
+		return nil
+	case time.Time:
+		*t = TimeOfDayOf(x)
+		return nil
+	case string:
+		return t.scanText(x)
+	case []byte:
+		return t.scanText(string(x))
+	}
+	*t = TimeOfDay{}
+	return fmt.Errorf("null: couldn't scan TimeOfDay: unsupported type %T", src)
+}
+
+func (t *TimeOfDay) scanText(s string) error {
+	v, err := parseTimeOfDay(s)
+	if err != nil {
+		*t = TimeOfDay{}
+		return fmt.Errorf("null: couldn't scan TimeOfDay: %w", err)
+	}
+	*t = v
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns a "15:04:05.999999999" string, which databases accept for TIME columns.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return string(t.appendText(nil)), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this TimeOfDay is null.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this TimeOfDay to dst, as MarshalJSON does.
+// It will append null if this TimeOfDay is null.
+func (t TimeOfDay) AppendJSON(dst []byte) ([]byte, error) {
+	if !t.Valid {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '"')
+	dst = t.appendText(dst)
+	return append(dst, '"'), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		*t = TimeOfDay{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := parseTimeOfDay(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	*t = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this TimeOfDay is null.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this TimeOfDay is null.
+func (t TimeOfDay) AppendText(dst []byte) ([]byte, error) {
+	if !t.Valid {
+		return dst, nil
+	}
+	return t.appendText(dst), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null TimeOfDay if the input is blank or "null".
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		*t = TimeOfDay{}
+		return nil
+	}
+	v, err := parseTimeOfDay(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	*t = v
+	return nil
+}
+
+// SetValid changes this TimeOfDay to d after midnight and sets it to be non-null.
+// Durations outside of a single day wrap around.
+func (t *TimeOfDay) SetValid(d time.Duration) {
+	*t = TimeOfDayOf(time.Time{}.Add(wrapDay(d)))
+}
+
+// Ptr returns a pointer to the time elapsed since midnight,
+// or a nil pointer if this TimeOfDay is null.
+func (t TimeOfDay) Ptr() *time.Duration {
+	if !t.Valid {
+		return nil
+	}
+	d := t.sinceMidnight()
+	return &d
+}
+
+// IsZero returns true for null TimeOfDays.
+// A non-null TimeOfDay at midnight will not be considered zero.
+func (t TimeOfDay) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal returns true if both TimeOfDays represent the same time or are both null.
+func (t TimeOfDay) Equal(other TimeOfDay) bool {
+	return t.Valid == other.Valid && (!t.Valid || t.sinceMidnight() == other.sinceMidnight())
+}
+
+// Compare returns an integer comparing two TimeOfDays, following the conventions of cmp.Compare.
+// Null is less than any non-null TimeOfDay.
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	return internal.Compare(t.sinceMidnight(), t.Valid, other.sinceMidnight(), other.Valid)
+}
+
+// Before reports whether t is earlier in the day than other. It returns false if either is null.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.Valid && other.Valid && t.sinceMidnight() < other.sinceMidnight()
+}
+
+// After reports whether t is later in the day than other. It returns false if either is null.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.Valid && other.Valid && t.sinceMidnight() > other.sinceMidnight()
+}
+
+// Add returns the time of day d after t, wrapping around midnight.
+// For example, 23:00 plus 2 hours is 01:00. If t is null, the result is null.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	if !t.Valid {
+		return t
+	}
+	var result TimeOfDay
+	result.SetValid(t.sinceMidnight() + wrapDay(d))
+	return result
+}
+
+func (t TimeOfDay) sinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond)
+}
+
+func (t TimeOfDay) appendText(b []byte) []byte {
+	if t.sinceMidnight() == 24*time.Hour {
+		return append(b, endOfDay...)
+	}
+	return time.Date(0, 1, 1, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC).AppendFormat(b, timeOfDayLayout)
+}
+
+// parseTimeOfDay parses s in the format of timeOfDayLayout, or "24:00:00" with an optional zero fraction.
+func parseTimeOfDay(s string) (TimeOfDay, error) {
+	if frac, ok := strings.CutPrefix(s, endOfDay); ok {
+		if frac == "" || len(frac) > 1 && frac[0] == '.' && strings.Trim(frac[1:], "0") == "" {
+			return TimeOfDayFrom(24, 0, 0, 0), nil
+		}
+	}
+	v, err := time.Parse(timeOfDayLayout, s)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDayOf(v), nil
+}
+
+// wrapDay returns d modulo 24 hours, in the range [0, 24h).
+func wrapDay(d time.Duration) time.Duration {
+	const day = 24 * time.Hour
+	d %= day
+	if d < 0 {
+		d += day
+	}
+	return d
+}
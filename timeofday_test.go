@@ -0,0 +1,221 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	timeOfDayString = "13:45:00.123456"
+	timeOfDayJSON   = []byte(`"` + timeOfDayString + `"`)
+	timeOfDayValue  = TimeOfDayFrom(13, 45, 0, 123456000)
+)
+
+func TestTimeOfDayFrom(t *testing.T) {
+	assertTimeOfDay(t, timeOfDayValue, "TimeOfDayFrom()")
+	assertTimeOfDay(t, TimeOfDayOf(time.Date(2024, time.May, 1, 13, 45, 0, 123456000, time.UTC)), "TimeOfDayOf()")
+
+	d := 13*time.Hour + 45*time.Minute + 123456*time.Microsecond
+	assertTimeOfDay(t, TimeOfDayFromPtr(&d), "TimeOfDayFromPtr()")
+	assertNullTimeOfDay(t, TimeOfDayFromPtr(nil), "TimeOfDayFromPtr(nil)")
+
+	if got := timeOfDayValue.ValueOrZero(); got != d {
+		t.Errorf("bad ValueOrZero(): %v ≠ %v", got, d)
+	}
+	if got := (TimeOfDay{}).ValueOr(time.Hour); got != time.Hour {
+		t.Errorf("bad ValueOr(): %v", got)
+	}
+}
+
+func TestUnmarshalTimeOfDayJSON(t *testing.T) {
+	var tod TimeOfDay
+	err := json.Unmarshal(timeOfDayJSON, &tod)
+	maybePanic(err)
+	assertTimeOfDay(t, tod, "UnmarshalJSON() json")
+
+	var null TimeOfDay
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullTimeOfDay(t, null, "null json")
+
+	var invalid TimeOfDay
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullTimeOfDay(t, invalid, "invalid json")
+
+	var bad TimeOfDay
+	if err := json.Unmarshal([]byte(`"25:00:00"`), &bad); err == nil {
+		t.Error("expected error: bad hour")
+	}
+
+	var wrongType TimeOfDay
+	if err := json.Unmarshal(intJSON, &wrongType); err == nil {
+		t.Error("expected error: wrong type JSON")
+	}
+}
+
+func TestMarshalTimeOfDay(t *testing.T) {
+	data, err := json.Marshal(timeOfDayValue)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(timeOfDayJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(TimeOfDay{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = TimeOfDayFrom(9, 0, 0, 0).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "09:00:00", "whole seconds text marshal")
+
+	data, err = TimeOfDayFrom(23, 59, 59, 999999999).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "23:59:59.999999999", "nanosecond text marshal")
+
+	data, err = TimeOfDay{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	parsed, err := ParseTimeOfDay(timeOfDayString)
+	maybePanic(err)
+	assertTimeOfDay(t, parsed, "ParseTimeOfDay()")
+}
+
+func TestTimeOfDayScanValue(t *testing.T) {
+	for _, src := range []any{
+		timeOfDayString,
+		[]byte(timeOfDayString),
+		"13:45:00.123456000",
+		time.Date(0, time.January, 1, 13, 45, 0, 123456000, time.UTC),
+	} {
+		var tod TimeOfDay
+		err := tod.Scan(src)
+		maybePanic(err)
+		assertTimeOfDay(t, tod, "scanned")
+	}
+
+	var null TimeOfDay
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullTimeOfDay(t, null, "scanned null")
+
+	var bad TimeOfDay
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error: scanning int64")
+	}
+	assertNullTimeOfDay(t, bad, "scanned int64")
+
+	v, err := timeOfDayValue.Value()
+	maybePanic(err)
+	if v != timeOfDayString {
+		t.Errorf("bad Value(): %v ≠ %s", v, timeOfDayString)
+	}
+	v, err = TimeOfDay{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null Value() should be nil, got %v", v)
+	}
+}
+
+func TestTimeOfDayEndOfDay(t *testing.T) {
+	end := TimeOfDayFrom(24, 0, 0, 0)
+	for _, src := range []any{"24:00:00", []byte("24:00:00"), "24:00:00.000000"} {
+		var tod TimeOfDay
+		err := tod.Scan(src)
+		maybePanic(err)
+		assertTimeOfDayEqual(t, tod, end, "scanned end of day")
+	}
+	parsed, err := ParseTimeOfDay("24:00:00")
+	maybePanic(err)
+	assertTimeOfDayEqual(t, parsed, end, "parsed end of day")
+	for _, s := range []string{"24:00:01", "24:00:00.1", "24:00:00.", "24:01:00"} {
+		if _, err := ParseTimeOfDay(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+
+	v, err := end.Value()
+	maybePanic(err)
+	if v != "24:00:00" {
+		t.Errorf("bad end of day Value(): %v", v)
+	}
+	data, err := json.Marshal(end)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"24:00:00"`, "end of day json marshal")
+	var back TimeOfDay
+	err = json.Unmarshal(data, &back)
+	maybePanic(err)
+	assertTimeOfDayEqual(t, back, end, "end of day json unmarshal")
+	bin, err := end.MarshalBinary()
+	maybePanic(err)
+	back = TimeOfDay{}
+	err = back.UnmarshalBinary(bin)
+	maybePanic(err)
+	assertTimeOfDayEqual(t, back, end, "end of day binary")
+
+	midnight := TimeOfDayFrom(0, 0, 0, 0)
+	if end.Equal(midnight) || !end.After(TimeOfDayFrom(23, 59, 59, 999999999)) {
+		t.Error("end of day should be after every other time of day")
+	}
+	assertTimeOfDayEqual(t, end.Add(time.Hour), TimeOfDayFrom(1, 0, 0, 0), "end of day plus an hour")
+	on := end.On(DateFrom(2024, time.May, 1), time.UTC)
+	if want := time.Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC); !on.Equal(want) {
+		t.Errorf("bad end of day On(): %v ≠ %v", on, want)
+	}
+}
+
+func TestTimeOfDayArithmetic(t *testing.T) {
+	late := TimeOfDayFrom(23, 0, 0, 0)
+	assertTimeOfDayEqual(t, late.Add(2*time.Hour), TimeOfDayFrom(1, 0, 0, 0), "wraparound")
+	assertTimeOfDayEqual(t, late.Add(-24*time.Hour), late, "minus a day")
+	assertTimeOfDayEqual(t, TimeOfDayFrom(0, 30, 0, 0).Add(-time.Hour), TimeOfDayFrom(23, 30, 0, 0), "negative wraparound")
+	assertTimeOfDayEqual(t, late.Add(49*time.Hour+time.Nanosecond), TimeOfDayFrom(0, 0, 0, 1), "multiple days")
+	assertTimeOfDayEqual(t, TimeOfDay{}.Add(time.Hour), TimeOfDay{}, "null")
+}
+
+func TestTimeOfDayCompare(t *testing.T) {
+	later := timeOfDayValue.Add(time.Nanosecond)
+	assertCompare(t, timeOfDayValue.Compare(later), -1, "value vs later")
+	assertCompare(t, later.Compare(timeOfDayValue), 1, "later vs value")
+	assertCompare(t, TimeOfDay{}.Compare(TimeOfDayFrom(0, 0, 0, 0)), -1, "null vs midnight")
+
+	if !timeOfDayValue.Before(later) || !later.After(timeOfDayValue) {
+		t.Error("bad Before/After")
+	}
+	if timeOfDayValue.Before(TimeOfDay{}) || timeOfDayValue.After(TimeOfDay{}) {
+		t.Error("comparisons with null should be false")
+	}
+	if !timeOfDayValue.Equal(TimeOfDayFrom(13, 45, 0, 123456000)) || timeOfDayValue.Equal(later) {
+		t.Error("bad Equal")
+	}
+
+	on := timeOfDayValue.On(DateFrom(2024, time.May, 1), time.UTC)
+	if want := time.Date(2024, time.May, 1, 13, 45, 0, 123456000, time.UTC); !on.Equal(want) {
+		t.Errorf("bad On(): %v ≠ %v", on, want)
+	}
+}
+
+func assertTimeOfDay(t *testing.T, tod TimeOfDay, from string) {
+	t.Helper()
+	if !tod.Equal(timeOfDayValue) {
+		t.Errorf("bad %v time of day: %#v ≠ %s", from, tod, timeOfDayString)
+	}
+}
+
+func assertNullTimeOfDay(t *testing.T, tod TimeOfDay, from string) {
+	t.Helper()
+	if tod.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertTimeOfDayEqual(t *testing.T, got, want TimeOfDay, from string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("bad %s: %#v ≠ %#v", from, got, want)
+	}
+}
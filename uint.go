@@ -0,0 +1,160 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Uint is a nullable uint64.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint struct {
+	Uint64 uint64
+	Valid  bool // Valid is true if Uint64 is not NULL
+}
+
+// Uint64 is an alias for Uint.
+type Uint64 = Uint
+
+// NewUint creates a new Uint.
+func NewUint(i uint64, valid bool) Uint {
+	return Uint{
+		Uint64: i,
+		Valid:  valid,
+	}
+}
+
+// UintFrom creates a new Uint that will always be valid.
+func UintFrom(i uint64) Uint {
+	return NewUint(i, true)
+}
+
+// UintFromPtr creates a new Uint that be null if i is nil.
+func UintFromPtr(i *uint64) Uint {
+	if i == nil {
+		return NewUint(0, false)
+	}
+	return NewUint(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint) ValueOrZero() uint64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint64
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (i Uint) ValueOr(v uint64) uint64 {
+	if !i.Valid {
+		return v
+	}
+	return i.Uint64
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// so the full range of uint64 can be scanned.
+func (i *Uint) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Uint64, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't scan Uint: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns an error if this Uint's value is too large for int64,
+// the largest integer type supported by database/sql/driver.
+func (i Uint) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	if i.Uint64 > math.MaxInt64 {
+		return nil, fmt.Errorf("null: Uint value %d overflows int64", i.Uint64)
+	}
+	return int64(i.Uint64), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint.
+func (i *Uint) UnmarshalJSON(data []byte) error {
+	return internal.UnmarshalIntJSON(data, &i.Uint64, &i.Valid, 64, strconv.ParseUint)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint if the input is blank.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint) UnmarshalText(text []byte) error {
+	return internal.UnmarshalIntText(text, &i.Uint64, &i.Valid, 64, strconv.ParseUint)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Uint to dst, as MarshalJSON does.
+// It will append null if this Uint is null.
+func (i Uint) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, "null"...), nil
+	}
+	return strconv.AppendUint(dst, i.Uint64, 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint is null.
+func (i Uint) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Uint is null.
+func (i Uint) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return dst, nil
+	}
+	return strconv.AppendUint(dst, i.Uint64, 10), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (i *Uint) SetValid(n uint64) {
+	i.Uint64 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
+func (i Uint) Ptr() *uint64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint64
+}
+
+// IsZero returns true for invalid Uints, for future omitempty support (Go 1.4?)
+// A non-null Uint with a 0 value will not be considered zero.
+func (i Uint) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Uint) Equal(other Uint) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint64 == other.Uint64)
+}
+
+// Compare returns an integer comparing two Uints, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (i Uint) Compare(other Uint) int {
+	return internal.Compare(i.Uint64, i.Valid, other.Uint64, other.Valid)
+}
+
+func (i Uint) value() (uint64, bool) {
+	return i.Uint64, i.Valid
+}
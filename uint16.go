@@ -0,0 +1,151 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Uint16 is a nullable uint16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool // Valid is true if Uint16 is not NULL
+}
+
+// NewUint16 creates a new Uint16.
+func NewUint16(i uint16, valid bool) Uint16 {
+	return Uint16{
+		Uint16: i,
+		Valid:  valid,
+	}
+}
+
+// Uint16From creates a new Uint16 that will always be valid.
+func Uint16From(i uint16) Uint16 {
+	return NewUint16(i, true)
+}
+
+// Uint16FromPtr creates a new Uint16 that be null if i is nil.
+func Uint16FromPtr(i *uint16) Uint16 {
+	if i == nil {
+		return NewUint16(0, false)
+	}
+	return NewUint16(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint16) ValueOrZero() uint16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint16
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (i Uint16) ValueOr(v uint16) uint16 {
+	if !i.Valid {
+		return v
+	}
+	return i.Uint16
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range.
+func (i *Uint16) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Uint16, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't scan Uint16: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (i Uint16) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Uint16), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint16.
+func (i *Uint16) UnmarshalJSON(data []byte) error {
+	return internal.UnmarshalIntJSON(data, &i.Uint16, &i.Valid, 16, strconv.ParseUint)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint16 if the input is blank.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint16) UnmarshalText(text []byte) error {
+	return internal.UnmarshalIntText(text, &i.Uint16, &i.Valid, 16, strconv.ParseUint)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Uint16 to dst, as MarshalJSON does.
+// It will append null if this Uint16 is null.
+func (i Uint16) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, "null"...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint16), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint16 is null.
+func (i Uint16) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Uint16 is null.
+func (i Uint16) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return dst, nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint16), 10), nil
+}
+
+// SetValid changes this Uint16's value and also sets it to be non-null.
+func (i *Uint16) SetValid(n uint16) {
+	i.Uint16 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
+func (i Uint16) Ptr() *uint16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint16
+}
+
+// IsZero returns true for invalid Uint16s, for future omitempty support (Go 1.4?)
+// A non-null Uint16 with a 0 value will not be considered zero.
+func (i Uint16) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Uint16) Equal(other Uint16) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint16 == other.Uint16)
+}
+
+// Compare returns an integer comparing two Uint16s, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (i Uint16) Compare(other Uint16) int {
+	return internal.Compare(i.Uint16, i.Valid, other.Uint16, other.Valid)
+}
+
+func (i Uint16) value() (uint64, bool) {
+	return uint64(i.Uint16), i.Valid
+}
@@ -0,0 +1,151 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Uint32 is a nullable uint32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool // Valid is true if Uint32 is not NULL
+}
+
+// NewUint32 creates a new Uint32.
+func NewUint32(i uint32, valid bool) Uint32 {
+	return Uint32{
+		Uint32: i,
+		Valid:  valid,
+	}
+}
+
+// Uint32From creates a new Uint32 that will always be valid.
+func Uint32From(i uint32) Uint32 {
+	return NewUint32(i, true)
+}
+
+// Uint32FromPtr creates a new Uint32 that be null if i is nil.
+func Uint32FromPtr(i *uint32) Uint32 {
+	if i == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint32) ValueOrZero() uint32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint32
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (i Uint32) ValueOr(v uint32) uint32 {
+	if !i.Valid {
+		return v
+	}
+	return i.Uint32
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range.
+func (i *Uint32) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Uint32, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't scan Uint32: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (i Uint32) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Uint32), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint32.
+func (i *Uint32) UnmarshalJSON(data []byte) error {
+	return internal.UnmarshalIntJSON(data, &i.Uint32, &i.Valid, 32, strconv.ParseUint)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint32 if the input is blank.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint32) UnmarshalText(text []byte) error {
+	return internal.UnmarshalIntText(text, &i.Uint32, &i.Valid, 32, strconv.ParseUint)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Uint32 to dst, as MarshalJSON does.
+// It will append null if this Uint32 is null.
+func (i Uint32) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, "null"...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint32), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint32 is null.
+func (i Uint32) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Uint32 is null.
+func (i Uint32) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return dst, nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint32), 10), nil
+}
+
+// SetValid changes this Uint32's value and also sets it to be non-null.
+func (i *Uint32) SetValid(n uint32) {
+	i.Uint32 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
+func (i Uint32) Ptr() *uint32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint32
+}
+
+// IsZero returns true for invalid Uint32s, for future omitempty support (Go 1.4?)
+// A non-null Uint32 with a 0 value will not be considered zero.
+func (i Uint32) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Uint32) Equal(other Uint32) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint32 == other.Uint32)
+}
+
+// Compare returns an integer comparing two Uint32s, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (i Uint32) Compare(other Uint32) int {
+	return internal.Compare(i.Uint32, i.Valid, other.Uint32, other.Valid)
+}
+
+func (i Uint32) value() (uint64, bool) {
+	return uint64(i.Uint32), i.Valid
+}
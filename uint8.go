@@ -0,0 +1,151 @@
+package null
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Uint8 is a nullable uint8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Uint8 struct {
+	Uint8 uint8
+	Valid bool // Valid is true if Uint8 is not NULL
+}
+
+// NewUint8 creates a new Uint8.
+func NewUint8(i uint8, valid bool) Uint8 {
+	return Uint8{
+		Uint8: i,
+		Valid: valid,
+	}
+}
+
+// Uint8From creates a new Uint8 that will always be valid.
+func Uint8From(i uint8) Uint8 {
+	return NewUint8(i, true)
+}
+
+// Uint8FromPtr creates a new Uint8 that be null if i is nil.
+func Uint8FromPtr(i *uint8) Uint8 {
+	if i == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint8) ValueOrZero() uint8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint8
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (i Uint8) ValueOr(v uint8) uint8 {
+	if !i.Valid {
+		return v
+	}
+	return i.Uint8
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range.
+func (i *Uint8) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Uint8, &i.Valid); err != nil {
+		return fmt.Errorf("null: couldn't scan Uint8: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (i Uint8) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Uint8), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint8.
+func (i *Uint8) UnmarshalJSON(data []byte) error {
+	return internal.UnmarshalIntJSON(data, &i.Uint8, &i.Valid, 8, strconv.ParseUint)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint8 if the input is blank.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint8) UnmarshalText(text []byte) error {
+	return internal.UnmarshalIntText(text, &i.Uint8, &i.Valid, 8, strconv.ParseUint)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Uint8 to dst, as MarshalJSON does.
+// It will append null if this Uint8 is null.
+func (i Uint8) AppendJSON(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return append(dst, "null"...), nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint8), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint8 is null.
+func (i Uint8) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Uint8 is null.
+func (i Uint8) AppendText(dst []byte) ([]byte, error) {
+	if !i.Valid {
+		return dst, nil
+	}
+	return strconv.AppendUint(dst, uint64(i.Uint8), 10), nil
+}
+
+// SetValid changes this Uint8's value and also sets it to be non-null.
+func (i *Uint8) SetValid(n uint8) {
+	i.Uint8 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (i Uint8) Ptr() *uint8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint8
+}
+
+// IsZero returns true for invalid Uint8s, for future omitempty support (Go 1.4?)
+// A non-null Uint8 with a 0 value will not be considered zero.
+func (i Uint8) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal returns true if both ints have the same value or are both null.
+func (i Uint8) Equal(other Uint8) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.Uint8 == other.Uint8)
+}
+
+// Compare returns an integer comparing two Uint8s, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (i Uint8) Compare(other Uint8) int {
+	return internal.Compare(i.Uint8, i.Valid, other.Uint8, other.Valid)
+}
+
+func (i Uint8) value() (uint64, bool) {
+	return uint64(i.Uint8), i.Valid
+}
@@ -0,0 +1,207 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// UUID is a nullable UUID.
+// It does not consider the all-zero UUID to be null.
+// It will marshal to null if null, and to the canonical string form otherwise.
+type UUID struct {
+	UUID  [16]byte
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// NewUUID creates a new UUID.
+func NewUUID(u [16]byte, valid bool) UUID {
+	return UUID{
+		UUID:  u,
+		Valid: valid,
+	}
+}
+
+// UUIDFrom creates a new UUID that will always be valid.
+func UUIDFrom(u [16]byte) UUID {
+	return NewUUID(u, true)
+}
+
+// UUIDFromPtr creates a new UUID that will be null if u is nil.
+func UUIDFromPtr(u *[16]byte) UUID {
+	if u == nil {
+		return NewUUID([16]byte{}, false)
+	}
+	return NewUUID(*u, true)
+}
+
+// ParseUUID parses a UUID in the canonical form, such as "f81d4fae-7dec-11d0-a765-00a0c91e6bf6".
+// It also accepts braced and URN forms, and 32 hex digits without hyphens.
+// It returns a null UUID if s is blank.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	err := u.UnmarshalText([]byte(s))
+	return u, err
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the all-zero UUID.
+func (u UUID) ValueOrZero() [16]byte {
+	if !u.Valid {
+		return [16]byte{}
+	}
+	return u.UUID
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (u UUID) ValueOr(v [16]byte) [16]byte {
+	if !u.Valid {
+		return v
+	}
+	return u.UUID
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts strings in the forms accepted by ParseUUID,
+// as well as raw 16-byte []byte values, such as from MySQL BINARY(16) columns.
+func (u *UUID) Scan(src any) error {
+	if src == nil {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	v, err := internal.ScanUUID(src)
+	if err != nil {
+		u.UUID, u.Valid = [16]byte{}, false
+		return fmt.Errorf("null: couldn't scan UUID: %w", err)
+	}
+	u.UUID, u.Valid = v, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It sends the canonical string form. Use UUIDBytes to send raw bytes instead.
+func (u UUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return internal.UUIDString(u.UUID), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == 'n' {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := internal.ParseUUID(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	u.UUID, u.Valid = v, true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UUID if the input is blank or "null".
+func (u *UUID) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	v, err := internal.ParseUUID(str)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	u.UUID, u.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UUID is null, otherwise the canonical string form.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this UUID to dst, as MarshalJSON does.
+// It will append null if this UUID is null.
+func (u UUID) AppendJSON(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '"')
+	dst = internal.AppendUUID(dst, u.UUID)
+	return append(dst, '"'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this UUID is null, otherwise the canonical string form.
+func (u UUID) MarshalText() ([]byte, error) {
+	return u.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this UUID is null.
+func (u UUID) AppendText(dst []byte) ([]byte, error) {
+	if !u.Valid {
+		return dst, nil
+	}
+	return internal.AppendUUID(dst, u.UUID), nil
+}
+
+// SetValid changes this UUID's value and also sets it to be non-null.
+func (u *UUID) SetValid(v [16]byte) {
+	u.UUID = v
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this UUID's value, or a nil pointer if this UUID is null.
+func (u UUID) Ptr() *[16]byte {
+	if !u.Valid {
+		return nil
+	}
+	return &u.UUID
+}
+
+// IsZero returns true for null UUIDs.
+// A non-null all-zero UUID will not be considered zero.
+func (u UUID) IsZero() bool {
+	return !u.Valid
+}
+
+// Equal returns true if both UUIDs have the same value or are both null.
+func (u UUID) Equal(other UUID) bool {
+	return u.Valid == other.Valid && (!u.Valid || u.UUID == other.UUID)
+}
+
+// Compare returns an integer comparing two UUIDs byte-wise, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func (u UUID) Compare(other UUID) int {
+	return internal.CompareFunc(u.UUID, u.Valid, other.UUID, other.Valid, compareUUID)
+}
+
+func compareUUID(a, b [16]byte) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// UUIDBytes is a UUID that sends its raw 16 bytes to the database, for columns such as MySQL's BINARY(16).
+// It is otherwise the same as UUID, and can be made from one with UUIDBytes{u}.
+type UUIDBytes struct {
+	UUID
+}
+
+// Value implements the driver Valuer interface.
+// It sends the raw 16 bytes, or nil if null.
+func (u UUIDBytes) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.UUID[:], nil
+}
@@ -0,0 +1,170 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	uuidString = "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"
+	uuidJSON   = []byte(`"` + uuidString + `"`)
+	uuidValue  = [16]byte{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+)
+
+func TestUUIDFrom(t *testing.T) {
+	assertUUID(t, UUIDFrom(uuidValue), "UUIDFrom()")
+	assertUUID(t, UUIDFromPtr(&uuidValue), "UUIDFromPtr()")
+	assertNullUUID(t, UUIDFromPtr(nil), "UUIDFromPtr(nil)")
+
+	if nilUUID := UUIDFrom([16]byte{}); !nilUUID.Valid {
+		t.Error("all-zero UUID should be valid")
+	}
+}
+
+func TestParseUUID(t *testing.T) {
+	for _, s := range []string{
+		uuidString,
+		"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6",
+		"{" + uuidString + "}",
+		"urn:uuid:" + uuidString,
+		"URN:UUID:" + uuidString,
+		"f81d4fae7dec11d0a76500a0c91e6bf6",
+	} {
+		u, err := ParseUUID(s)
+		maybePanic(err)
+		assertUUID(t, u, s)
+	}
+
+	for _, s := range []string{
+		"f81d4fae-7dec-11d0-a765-00a0c91e6bf",
+		"f81d4fae_7dec_11d0_a765_00a0c91e6bf6",
+		"g81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+		"(" + uuidString + ")",
+		"urn:uid:" + uuidString + "0",
+	} {
+		if _, err := ParseUUID(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+
+	blank, err := ParseUUID("")
+	maybePanic(err)
+	assertNullUUID(t, blank, "blank")
+}
+
+func TestUUIDScanValue(t *testing.T) {
+	for _, src := range []any{uuidString, []byte(uuidString), uuidValue[:], []byte("{" + uuidString + "}")} {
+		var u UUID
+		err := u.Scan(src)
+		maybePanic(err)
+		assertUUID(t, u, "scanned")
+	}
+
+	var null UUID
+	err := null.Scan(nil)
+	maybePanic(err)
+	assertNullUUID(t, null, "scanned null")
+
+	var bad UUID
+	if err := bad.Scan([]byte{1, 2, 3}); err == nil {
+		t.Error("expected error: scanning short []byte")
+	}
+	if err := bad.Scan(int64(1)); err == nil {
+		t.Error("expected error: scanning int64")
+	}
+	assertNullUUID(t, bad, "bad scan")
+
+	v, err := UUIDFrom(uuidValue).Value()
+	maybePanic(err)
+	if v != uuidString {
+		t.Errorf("bad text Value(): %#v", v)
+	}
+
+	v, err = UUIDBytes{UUIDFrom(uuidValue)}.Value()
+	maybePanic(err)
+	if b, ok := v.([]byte); !ok || !bytes.Equal(b, uuidValue[:]) {
+		t.Errorf("bad binary Value(): %#v", v)
+	}
+	v, err = UUIDBytes{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null UUIDBytes Value() should be nil, got %#v", v)
+	}
+
+	var ub UUIDBytes
+	err = ub.Scan(uuidValue[:])
+	maybePanic(err)
+	assertUUID(t, ub.UUID, "scanned UUIDBytes")
+	data, err := json.Marshal(ub)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+uuidString+`"`, "UUIDBytes json marshal")
+
+	v, err = UUID{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null Value() should be nil, got %#v", v)
+	}
+}
+
+func TestMarshalUUID(t *testing.T) {
+	data, err := json.Marshal(UUIDFrom(uuidValue))
+	maybePanic(err)
+	assertJSONEquals(t, data, string(uuidJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(UUID{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+
+	data, err = UUIDFrom(uuidValue).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, uuidString, "text marshal")
+
+	data, err = UUID{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+
+	var u UUID
+	err = json.Unmarshal(uuidJSON, &u)
+	maybePanic(err)
+	assertUUID(t, u, "unmarshal json")
+
+	err = json.Unmarshal(nullJSON, &u)
+	maybePanic(err)
+	assertNullUUID(t, u, "unmarshal null json")
+
+	if err := json.Unmarshal([]byte(`"nope"`), &u); err == nil {
+		t.Error("expected error: bad UUID")
+	}
+	if err := json.Unmarshal(intJSON, &u); err == nil {
+		t.Error("expected error: wrong type JSON")
+	}
+}
+
+func TestUUIDEqual(t *testing.T) {
+	if !UUIDFrom(uuidValue).Equal(UUIDFrom(uuidValue)) {
+		t.Error("same UUIDs should be equal")
+	}
+	if UUIDFrom([16]byte{}).Equal(UUID{}) {
+		t.Error("all-zero UUID should not equal null")
+	}
+	assertCompare(t, UUIDFrom([16]byte{1}).Compare(UUIDFrom([16]byte{2})), -1, "1 vs 2")
+	assertCompare(t, UUID{}.Compare(UUIDFrom([16]byte{})), -1, "null vs zero")
+}
+
+func assertUUID(t *testing.T, u UUID, from string) {
+	t.Helper()
+	if u.UUID != uuidValue {
+		t.Errorf("bad %v UUID: %x ≠ %x", from, u.UUID, uuidValue)
+	}
+	if !u.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUUID(t *testing.T, u UUID, from string) {
+	t.Helper()
+	if u.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -3,9 +3,13 @@
 package null
 
 import (
+	"cmp"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 // Value represents a value that may be null.
@@ -57,10 +61,17 @@ func (t Value[T]) ValueOr(v T) T {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this value is null.
 func (t Value[T]) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Value to dst, as MarshalJSON does.
+// It will append null if this value is null.
+func (t Value[T]) AppendJSON(dst []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
 	}
-	return json.Marshal(t.V)
+	data, err := json.Marshal(t.V)
+	return append(dst, data...), err
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -79,6 +90,42 @@ func (t *Value[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode null with an xsi:nil="true" attribute, otherwise T as encoding/xml would.
+func (t Value[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !t.Valid {
+		return internal.MarshalXMLNull(e, start)
+	}
+	return e.EncodeElement(t.V, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Elements with an xsi:nil="true" attribute decode to null.
+func (t *Value[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	valid, err := unmarshalXMLValue(d, start, &t.V)
+	t.Valid = valid
+	return err
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Value is null.
+func (t Value[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !t.Valid {
+		return xml.Attr{}, nil
+	}
+	return marshalXMLAttrValue(name, &t.V)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *Value[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if err := unmarshalXMLAttrValue(attr, &t.V); err != nil {
+		t.Valid = false
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
 /*
 // MarshalText implements encoding.TextMarshaler.
 // It returns an empty string if invalid, otherwise T's MarshalText.
@@ -126,6 +173,43 @@ try:
 }
 */
 
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Value is null,
+// otherwise T using its MarshalBinary method if it has one, or as JSON.
+func (t Value[T]) MarshalBinary() ([]byte, error) {
+	b := internal.AppendBinaryHeader(nil, t.Valid)
+	if !t.Valid {
+		return b, nil
+	}
+	return internal.AppendBinaryValue(b, t.V)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes T using its UnmarshalBinary method if it has one, or as JSON.
+func (t *Value[T]) UnmarshalBinary(data []byte) error {
+	var zero T
+	t.V, t.Valid = zero, false
+	payload, valid, err := internal.ReadBinary(data)
+	if err == nil && valid {
+		err = internal.ParseBinaryValue(payload, &t.V)
+	}
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal binary: %w", err)
+	}
+	t.Valid = valid
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (t Value[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (t *Value[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
 // SetValid changes this Value's value and sets it to be non-null.
 func (t *Value[T]) SetValid(v T) {
 	t.V = v
@@ -146,6 +230,19 @@ func (t Value[T]) IsZero() bool {
 	return !t.Valid
 }
 
+// CompareFunc returns an integer comparing two Values, following the conventions of cmp.Compare.
+// Null is less than any non-null value, and non-null values are compared with cmp,
+// such as time.Time.Compare. For ordered types, CompareValue is shorter.
+func (t Value[T]) CompareFunc(other Value[T], cmp func(a, b T) int) int {
+	return internal.CompareFunc(t.V, t.Valid, other.V, other.Valid, cmp)
+}
+
+// CompareValue returns an integer comparing two Values of an ordered type, following the conventions of cmp.Compare.
+// Null is less than any non-null value.
+func CompareValue[T cmp.Ordered](a, b Value[T]) int {
+	return a.CompareFunc(b, cmp.Compare[T])
+}
+
 /*
 // Equal returns true if both Value objects encode the same value or are both null.
 func (t Value[T]) Equal(other Value[T]) bool {
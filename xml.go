@@ -0,0 +1,735 @@
+package null
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// The types in this package encode XML elements and attributes using their text form,
+// as given by MarshalText, and decode them with UnmarshalText.
+// Elements with an xsi:nil="true" attribute decode to null.
+// Use XMLOmitNull to leave out null elements instead.
+
+// XMLOmitNull wraps one of this package's types so that null values are left out of XML entirely,
+// instead of being written as elements with an xsi:nil="true" attribute.
+// It is otherwise encoded and decoded the same way as V.
+// Null is reported by V's IsZero method, so unset Optionals are left out, but null Optionals are not.
+type XMLOmitNull[T xmlNullable] struct {
+	V T
+}
+
+type xmlNullable interface {
+	xml.Marshaler
+	xml.MarshalerAttr
+	IsZero() bool
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode nothing if V is null.
+func (x XMLOmitNull[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if x.V.IsZero() {
+		return nil
+	}
+	return x.V.MarshalXML(e, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (x *XMLOmitNull[T]) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return dec.DecodeElement(&x.V, &start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if V is null.
+func (x XMLOmitNull[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return x.V.MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (x *XMLOmitNull[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return internal.UnmarshalXMLAttrText(&x.V, attr)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, s, s.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Unlike UnmarshalText, an empty element decodes to a valid empty String.
+func (s *String) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	text, err := internal.DecodeXMLText(dec, start)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal XML: %w", err)
+	}
+	s.String, s.Valid = string(text), text != nil
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this String is null.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s, s.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// Unlike UnmarshalText, an empty attribute decodes to a valid empty String.
+func (s *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	s.String, s.Valid = attr.Value, true
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Int is null.
+func (i Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Int32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Int32 is null.
+func (i Int32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Int16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int16) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Int16 is null.
+func (i Int16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Int8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int8) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Int8 is null.
+func (i Int8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (b Byte) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *Byte) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Byte is null.
+func (b Byte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b, b.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Byte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Uint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Uint is null.
+func (i Uint) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Uint32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Uint32 is null.
+func (i Uint32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Uint16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint16) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Uint16 is null.
+func (i Uint16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (i Uint8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i, i.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint8) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Uint8 is null.
+func (i Uint8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i, i.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (f Float) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, f, f.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (f *Float) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Float is null.
+func (f Float) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f, f.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *Float) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (f Float32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, f, f.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (f *Float32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Float32 is null.
+func (f Float32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f, f.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *Float32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (d Decimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d, d.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (d *Decimal) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Decimal is null.
+func (d Decimal) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d, d.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (d *Decimal) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (b BigInt) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *BigInt) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this BigInt is null.
+func (b BigInt) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b, b.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *BigInt) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *Bool) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Bool is null.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b, b.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, t, t.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (t *Time) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Time is null.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t, t.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d, d.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Date is null.
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d, d.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (t TimeOfDay) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, t, t.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (t *TimeOfDay) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this TimeOfDay is null.
+func (t TimeOfDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t, t.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *TimeOfDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (d Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d, d.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (d *Duration) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Duration is null.
+func (d Duration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d, d.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (d *Duration) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (j JSON) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, j, j.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (j *JSON) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, j)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this JSON is null.
+func (j JSON) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, j, j.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (j *JSON) UnmarshalXMLAttr(attr xml.Attr) error {
+	return j.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (b Bytes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Unlike UnmarshalText, an empty element decodes to a valid empty Bytes.
+func (b *Bytes) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	text, err := internal.DecodeXMLText(dec, start)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal XML: %w", err)
+	}
+	return unmarshalXMLBytes(b, text, internal.BytesBase64)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Bytes is null.
+func (b Bytes) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b, b.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// Unlike UnmarshalText, an empty attribute decodes to a valid empty Bytes.
+func (b *Bytes) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLBytes(b, []byte(attr.Value), internal.BytesBase64)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (b BytesHex) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b, b.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Unlike UnmarshalText, an empty element decodes to a valid empty BytesHex.
+func (b *BytesHex) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	text, err := internal.DecodeXMLText(dec, start)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal XML: %w", err)
+	}
+	return unmarshalXMLBytes(&b.Bytes, text, internal.BytesHex)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this BytesHex is null.
+func (b BytesHex) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b, b.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// Unlike UnmarshalText, an empty attribute decodes to a valid empty BytesHex.
+func (b *BytesHex) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLBytes(&b.Bytes, []byte(attr.Value), internal.BytesHex)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (u UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u, u.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (u *UUID) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this UUID is null.
+func (u UUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u, u.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (u *UUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (a Addr) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, a, a.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (a *Addr) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, a)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Addr is null.
+func (a Addr) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, a, a.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (a *Addr) UnmarshalXMLAttr(attr xml.Attr) error {
+	return a.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (p Prefix) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, p, p.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (p *Prefix) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, p)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this Prefix is null.
+func (p Prefix) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, p, p.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (p *Prefix) UnmarshalXMLAttr(attr xml.Attr) error {
+	return p.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode null as an empty element with an xsi:nil="true" attribute.
+func (ap AddrPort) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, ap, ap.Valid)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (ap *AddrPort) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, ap)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will omit the attribute if this AddrPort is null.
+func (ap AddrPort) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, ap, ap.Valid)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (ap *AddrPort) UnmarshalXMLAttr(attr xml.Attr) error {
+	return ap.UnmarshalText([]byte(attr.Value))
+}
+
+func marshalXML(e *xml.Encoder, start xml.StartElement, v encoding.TextMarshaler, valid bool) error {
+	if !valid {
+		return internal.MarshalXMLNull(e, start)
+	}
+	text, err := v.MarshalText()
+	if err != nil {
+		return err
+	}
+	return internal.MarshalXMLText(e, start, text)
+}
+
+func marshalXMLAttr(name xml.Name, v encoding.TextMarshaler, valid bool) (xml.Attr, error) {
+	if !valid {
+		return xml.Attr{}, nil
+	}
+	text, err := v.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// unmarshalXML decodes an element's text with v's UnmarshalText method.
+// Elements marked with xsi:nil set v to its zero value, which is null.
+func unmarshalXML[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](d *xml.Decoder, start xml.StartElement, v PT) error {
+	text, err := internal.DecodeXMLText(d, start)
+	if err != nil {
+		return fmt.Errorf("null: couldn't unmarshal XML: %w", err)
+	}
+	if text == nil {
+		var zero T
+		*v = zero
+		return nil
+	}
+	return v.UnmarshalText(text)
+}
+
+// unmarshalXMLBytes decodes text with enc, setting b to null if text is nil.
+// Other text, even if empty, is valid, so that empty values round trip.
+func unmarshalXMLBytes(b *Bytes, text []byte, enc internal.BytesEncoding) error {
+	if text == nil {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	v, err := internal.DecodeBytes(text, enc)
+	if err != nil {
+		b.Bytes, b.Valid = nil, false
+		return fmt.Errorf("null: couldn't unmarshal XML: %w", err)
+	}
+	if v == nil {
+		v = []byte{}
+	}
+	b.Bytes, b.Valid = v, true
+	return nil
+}
+
+// unmarshalXMLValue decodes an element into v as encoding/xml would,
+// reporting false if it is marked with xsi:nil.
+func unmarshalXMLValue[T any](d *xml.Decoder, start xml.StartElement, v *T) (bool, error) {
+	var zero T
+	*v = zero
+	if internal.IsXMLNil(start) {
+		return false, d.Skip()
+	}
+	if err := d.DecodeElement(v, &start); err != nil {
+		return false, fmt.Errorf("null: couldn't unmarshal XML: %w", err)
+	}
+	return true, nil
+}
+
+func marshalXMLAttrValue(name xml.Name, ptr any) (xml.Attr, error) {
+	text, ok, err := internal.XMLAttrText(ptr, name)
+	if err != nil || !ok {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+func unmarshalXMLAttrValue[T any](attr xml.Attr, v *T) error {
+	var zero T
+	*v = zero
+	if err := internal.UnmarshalXMLAttrText(v, attr); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal XML attribute: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package null
+
+import (
+	"encoding/xml"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+// xmlTypes is every type in this package, which should all support XML.
+var xmlTypes = []any{
+	String{}, Int{}, Int32{}, Int16{}, Int8{}, Byte{}, Uint{}, Uint32{}, Uint16{}, Uint8{},
+	Float{}, Float32{}, Decimal{}, BigInt{}, Bool{}, Time{}, Date{}, TimeOfDay{}, Duration{},
+	JSON{}, Bytes{}, UUID{}, Addr{}, Prefix{}, AddrPort{},
+	Value[int]{}, JSONValue[int]{}, Optional[int]{},
+}
+
+func TestXMLInterfaces(t *testing.T) {
+	for _, v := range xmlTypes {
+		if _, ok := v.(xml.Marshaler); !ok {
+			t.Errorf("%T doesn't implement xml.Marshaler", v)
+		}
+		if _, ok := v.(xml.MarshalerAttr); !ok {
+			t.Errorf("%T doesn't implement xml.MarshalerAttr", v)
+		}
+	}
+}
+
+type xmlRecord struct {
+	XMLName xml.Name        `xml:"record"`
+	ID      Int             `xml:"id,attr"`
+	Code    String          `xml:"code,attr"`
+	Name    String          `xml:"name"`
+	Score   Float           `xml:"score"`
+	Active  Bool            `xml:"active"`
+	Born    Date            `xml:"born"`
+	Seen    Time            `xml:"seen"`
+	IP      Addr            `xml:"ip"`
+	Tags    Value[[]string] `xml:"tags"`
+	Rank    Optional[int]   `xml:"rank"`
+	Missing Optional[int]   `xml:"missing"`
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	in := xmlRecord{
+		ID:     IntFrom(1),
+		Code:   StringFrom("a&b"),
+		Name:   StringFrom("<test>"),
+		Score:  FloatFrom(1.5),
+		Active: BoolFrom(false),
+		Born:   DateFrom(2000, time.January, 2),
+		Seen:   TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)),
+		IP:     AddrFrom(netip.MustParseAddr("192.0.2.1")),
+		Tags:   ValueFrom([]string{"x", "y"}),
+		Rank:   OptionalFrom(3),
+	}
+	data, err := xml.Marshal(in)
+	maybePanic(err)
+	want := `<record id="1" code="a&amp;b"><name>&lt;test&gt;</name><score>1.5</score><active>false</active>` +
+		`<born>2000-01-02</born><seen>2012-12-21T21:21:21Z</seen><ip>192.0.2.1</ip>` +
+		`<tags>x</tags><tags>y</tags><rank>3</rank></record>`
+	assertJSONEquals(t, data, want, "xml marshal")
+
+	var out xmlRecord
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.ID.Equal(in.ID) || !out.Code.Equal(in.Code) || !out.Name.Equal(in.Name) ||
+		!out.Score.Equal(in.Score) || !out.Active.Equal(in.Active) || !out.Born.Equal(in.Born) ||
+		!out.Seen.Equal(in.Seen) || !out.IP.Equal(in.IP) || out.Rank != in.Rank {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+	if !out.Tags.Valid || len(out.Tags.V) != 1 || out.Tags.V[0] != "y" {
+		// encoding/xml calls UnmarshalXML once per repeated element, so the last one wins
+		t.Errorf("bad Tags: %v", out.Tags)
+	}
+	if out.Missing.Present {
+		t.Error("missing Optional should not be present")
+	}
+}
+
+func TestXMLNull(t *testing.T) {
+	null := xmlRecord{Rank: OptionalNull[int]()}
+	data, err := xml.Marshal(null)
+	maybePanic(err)
+	nilElem := func(name string) string {
+		return `<` + name + ` xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></` + name + `>`
+	}
+	want := `<record>` + nilElem("name") + nilElem("score") + nilElem("active") + nilElem("born") +
+		nilElem("seen") + nilElem("ip") + nilElem("tags") + nilElem("rank") + `</record>`
+	assertJSONEquals(t, data, want, "xsi:nil marshal")
+
+	out := xmlRecord{Name: StringFrom("x"), Score: FloatFrom(1), Born: DateFrom(2000, 1, 1), Tags: ValueFrom([]string{"x"})}
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.Name.Valid || out.Score.Valid || out.Born.Valid || out.Tags.Valid {
+		t.Errorf("xsi:nil elements should be null: %+v", out)
+	}
+	if !out.Rank.Present || out.Rank.Valid {
+		t.Errorf("xsi:nil Optional should be present and null: %+v", out.Rank)
+	}
+
+}
+
+func TestXMLOmitNull(t *testing.T) {
+	type omitRecord struct {
+		XMLName xml.Name                `xml:"record"`
+		ID      XMLOmitNull[Int]        `xml:"id,attr"`
+		Name    XMLOmitNull[String]     `xml:"name"`
+		Tags    XMLOmitNull[Value[int]] `xml:"tags"`
+		Rank    XMLOmitNull[Optional[int]]
+	}
+	data, err := xml.Marshal(omitRecord{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `<record></record>`, "omitted null marshal")
+
+	in := omitRecord{
+		ID:   XMLOmitNull[Int]{IntFrom(1)},
+		Name: XMLOmitNull[String]{StringFrom("x")},
+		Tags: XMLOmitNull[Value[int]]{ValueFrom(2)},
+		Rank: XMLOmitNull[Optional[int]]{OptionalNull[int]()},
+	}
+	data, err = xml.Marshal(in)
+	maybePanic(err)
+	want := `<record id="1"><name>x</name><tags>2</tags>` +
+		`<Rank xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></Rank></record>`
+	assertJSONEquals(t, data, want, "valid marshal")
+
+	var out omitRecord
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.ID.V != in.ID.V || out.Name.V != in.Name.V || out.Tags.V != in.Tags.V || out.Rank.V != in.Rank.V {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+}
+
+func TestXMLEmpty(t *testing.T) {
+	type emptyRecord struct {
+		A     String   `xml:"a,attr"`
+		S     String   `xml:"s"`
+		B     Bytes    `xml:"b"`
+		H     BytesHex `xml:"h"`
+		NullS String   `xml:"ns"`
+		NullB Bytes    `xml:"nb"`
+	}
+	in := emptyRecord{
+		A: StringFrom(""),
+		S: StringFrom(""),
+		B: BytesFrom([]byte{}),
+		H: BytesHex{BytesFrom(nil)},
+	}
+	data, err := xml.Marshal(in)
+	maybePanic(err)
+	nilElem := func(name string) string {
+		return `<` + name + ` xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></` + name + `>`
+	}
+	want := `<emptyRecord a=""><s></s><b></b><h></h>` + nilElem("ns") + nilElem("nb") + `</emptyRecord>`
+	assertJSONEquals(t, data, want, "empty marshal")
+
+	var out emptyRecord
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.A.Equal(in.A) || !out.S.Equal(in.S) || !out.B.Equal(in.B) || !out.H.Equal(in.H.Bytes) ||
+		out.NullS.Valid || out.NullB.Valid {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+	if out.B.Bytes == nil {
+		t.Error("empty Bytes should not be nil")
+	}
+
+	var short emptyRecord
+	err = xml.Unmarshal([]byte(`<emptyRecord><s/><b/></emptyRecord>`), &short)
+	maybePanic(err)
+	if !short.S.Valid || !short.B.Valid || short.A.Valid {
+		t.Errorf("self-closing elements should be valid: %+v", short)
+	}
+}
+
+func TestXMLNilPrefix(t *testing.T) {
+	// the xsi prefix is commonly used without declaring it
+	var v struct {
+		N Int `xml:"n"`
+	}
+	v.N = IntFrom(1)
+	err := xml.Unmarshal([]byte(`<v><n xsi:nil="true"/></v>`), &v)
+	maybePanic(err)
+	assertNullInt(t, v.N, "undeclared xsi:nil")
+
+	err = xml.Unmarshal([]byte(`<v><n xsi:nil="false">5</n></v>`), &v)
+	maybePanic(err)
+	if v.N != IntFrom(5) {
+		t.Error("xsi:nil=false should be valid:", v.N)
+	}
+}
+
+func TestXMLErrors(t *testing.T) {
+	var v struct {
+		N Int   `xml:"n"`
+		A Int32 `xml:"a,attr"`
+	}
+	if err := xml.Unmarshal([]byte(`<v><n>abc</n></v>`), &v); err == nil {
+		t.Error("expected error: bad element")
+	}
+	if err := xml.Unmarshal([]byte(`<v a="abc"></v>`), &v); err == nil {
+		t.Error("expected error: bad attribute")
+	}
+}
+
+func TestXMLAttrGeneric(t *testing.T) {
+	type item struct {
+		Count Value[int]       `xml:"count,attr"`
+		Price Optional[string] `xml:"price,attr"`
+		Meta  JSONValue[bool]  `xml:"meta,attr"`
+	}
+	data, err := xml.Marshal(item{Count: ValueFrom(2), Price: OptionalFrom("1.50")})
+	maybePanic(err)
+	assertJSONEquals(t, data, `<item count="2" price="1.50"></item>`, "generic attr marshal")
+
+	var out item
+	err = xml.Unmarshal([]byte(`<item count="7" meta="true"></item>`), &out)
+	maybePanic(err)
+	if out.Count != ValueFrom(7) || out.Price.Present || out.Meta.V != true || !out.Meta.Valid {
+		t.Errorf("bad generic attr unmarshal: %+v", out)
+	}
+	if err := xml.Unmarshal([]byte(`<item count="x"></item>`), &out); err == nil {
+		t.Error("expected error: bad generic attribute")
+	}
+}
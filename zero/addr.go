@@ -0,0 +1,174 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Addr is a nullable netip.Addr, such as a Postgres INET column holding a host address.
+// JSON marshals to a blank string if null.
+// The zero netip.Addr, which is not a valid address, produces a null Addr.
+// Null values and zero values are considered equivalent.
+type Addr struct {
+	Addr  netip.Addr
+	Valid bool // Valid is true if Addr is not NULL
+}
+
+// NewAddr creates a new Addr.
+func NewAddr(a netip.Addr, valid bool) Addr {
+	return Addr{
+		Addr:  a,
+		Valid: valid,
+	}
+}
+
+// AddrFrom creates a new Addr that will be null if a is the zero value.
+func AddrFrom(a netip.Addr) Addr {
+	return NewAddr(a, a.IsValid())
+}
+
+// AddrFromPtr creates a new Addr that will be null if a is nil or the zero value.
+func AddrFromPtr(a *netip.Addr) Addr {
+	if a == nil {
+		return NewAddr(netip.Addr{}, false)
+	}
+	return AddrFrom(*a)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero netip.Addr.
+func (a Addr) ValueOrZero() netip.Addr {
+	if !a.Valid {
+		return netip.Addr{}
+	}
+	return a.Addr
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (a Addr) ValueOr(v netip.Addr) netip.Addr {
+	if !a.Valid {
+		return v
+	}
+	return a.Addr
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts string and []byte values, such as "192.0.2.1" or "2001:db8::1".
+// A prefix length is accepted if it covers the whole address, such as "192.0.2.1/32".
+func (a *Addr) Scan(src any) error {
+	if src == nil {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return nil
+	}
+	str, err := internal.ScanString(src)
+	if err == nil {
+		a.Addr, err = internal.ParseAddr(str)
+	}
+	if err != nil {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return fmt.Errorf("zero: couldn't scan Addr: %w", err)
+	}
+	a.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the address's text form.
+func (a Addr) Value() (driver.Value, error) {
+	if !a.Valid {
+		return nil, nil
+	}
+	return a.Addr.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+// Blank strings produce a null Addr.
+func (a *Addr) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	return a.UnmarshalText([]byte(str))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Addr if the input is blank or "null".
+// Like Scan, it accepts INET text with a prefix covering the whole address, such as "192.0.2.1/32".
+func (a *Addr) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return nil
+	}
+	v, err := internal.ParseAddr(str)
+	if err != nil {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	a.Addr, a.Valid = v, true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a blank string if this Addr is null, otherwise netip.Addr's text form.
+func (a Addr) MarshalJSON() ([]byte, error) {
+	return a.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Addr to dst, as MarshalJSON does.
+// It will append a blank string if this Addr is null.
+func (a Addr) AppendJSON(dst []byte) ([]byte, error) {
+	var buf [64]byte
+	return internal.AppendJSONString(dst, a.ValueOrZero().AppendTo(buf[:0])), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Addr is null, otherwise netip.Addr's text form.
+func (a Addr) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Addr is null.
+func (a Addr) AppendText(dst []byte) ([]byte, error) {
+	return a.ValueOrZero().AppendTo(dst), nil
+}
+
+// SetValid changes this Addr's value and also sets it to be non-null.
+func (a *Addr) SetValid(v netip.Addr) {
+	a.Addr = v
+	a.Valid = true
+}
+
+// Ptr returns a pointer to this Addr's value, or a nil pointer if this Addr is null.
+func (a Addr) Ptr() *netip.Addr {
+	if !a.Valid {
+		return nil
+	}
+	return &a.Addr
+}
+
+// IsZero returns true for null or zero Addrs, for potential future omitempty support.
+func (a Addr) IsZero() bool {
+	return !a.Valid || !a.Addr.IsValid()
+}
+
+// Equal returns true if both Addrs have the same value or are both either null or zero.
+func (a Addr) Equal(other Addr) bool {
+	return a.ValueOrZero() == other.ValueOrZero()
+}
+
+// Compare returns an integer comparing two Addrs, following the conventions of cmp.Compare.
+// Addresses are compared with netip.Addr's Compare method.
+// Null is considered equal to the zero netip.Addr.
+func (a Addr) Compare(other Addr) int {
+	return a.ValueOrZero().Compare(other.ValueOrZero())
+}
@@ -0,0 +1,88 @@
+package zero
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math"
+	"math/big"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+// textAppender is encoding.TextAppender, which needs Go 1.24.
+type textAppender interface {
+	AppendText(b []byte) ([]byte, error)
+}
+
+type jsonAppender interface {
+	AppendJSON(dst []byte) ([]byte, error)
+}
+
+func TestAppendMatchesMarshal(t *testing.T) {
+	values := []any{
+		StringFrom("<a&b>"), IntFrom(-12), Int8From(math.MinInt8), UintFrom(math.MaxUint64), Uint8From(9),
+		FloatFrom(1.5), Float32From(0.1), BigIntFrom(big.NewInt(-3)), BoolFrom(true),
+		TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 5, time.UTC)), DateFrom(2000, time.January, 2),
+		DurationFrom(90 * time.Second), JSONFrom([]byte(`{"a":1}`)), BytesFrom([]byte("hi")), UUIDFrom([16]byte{15: 1}),
+		AddrFrom(netip.MustParseAddr("192.0.2.1")), PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")),
+		AddrPortFrom(netip.MustParseAddrPort("[::1]:80")), ValueFrom("x"),
+	}
+	// null values are written as zero values
+	values = append(values, xmlTypes...)
+
+	prefix := []byte("prefix:")
+	for _, v := range values {
+		want, err := json.Marshal(v)
+		maybePanic(err)
+		got, err := v.(jsonAppender).AppendJSON(bytes.Clone(prefix))
+		maybePanic(err)
+		if !bytes.Equal(got, append(bytes.Clone(prefix), want...)) {
+			t.Errorf("%T %v: AppendJSON: %s ≠ %s", v, v, got, want)
+		}
+
+		tm, ok := v.(encoding.TextMarshaler)
+		if !ok {
+			continue
+		}
+		if _, ok := v.(textAppender); !ok {
+			t.Errorf("%T doesn't implement encoding.TextAppender", v)
+			continue
+		}
+		want, err = tm.MarshalText()
+		maybePanic(err)
+		got, err = v.(textAppender).AppendText(bytes.Clone(prefix))
+		maybePanic(err)
+		if !bytes.Equal(got, append(bytes.Clone(prefix), want...)) {
+			t.Errorf("%T %v: AppendText: %s ≠ %s", v, v, got, want)
+		}
+	}
+
+	if _, err := FloatFrom(math.Inf(1)).AppendJSON(nil); err == nil {
+		t.Error("expected error for +Inf, got nil")
+	}
+}
+
+func TestAppendAllocs(t *testing.T) {
+	buf := make([]byte, 0, 128)
+	for _, v := range []any{
+		IntFrom(123456), Int{}, FloatFrom(1.5), StringFrom("<hello>"), String{}, BoolFrom(true),
+		TimeFrom(time.Now()), Time{}, DateFrom(2000, 1, 2), DurationFrom(time.Minute),
+		UUIDFrom([16]byte{1}), AddrFrom(netip.MustParseAddr("192.0.2.1")),
+		BigIntFrom(big.NewInt(-3)), BigInt{}, BigIntString{BigIntFrom(big.NewInt(7))},
+	} {
+		if n := testing.AllocsPerRun(100, func() {
+			_, err := v.(jsonAppender).AppendJSON(buf[:0])
+			maybePanic(err)
+		}); n != 0 {
+			t.Errorf("%T: AppendJSON: %v allocations, want 0", v, n)
+		}
+		if n := testing.AllocsPerRun(100, func() {
+			_, err := v.(textAppender).AppendText(buf[:0])
+			maybePanic(err)
+		}); n != 0 {
+			t.Errorf("%T: AppendText: %v allocations, want 0", v, n)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package zero
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// benchTypes is the benchmark matrix: every type in this package with a representative JSON value.
+var benchTypes = []struct {
+	name string
+	v    any
+	json string
+}{
+	{"String", StringFrom("hello"), `"hello"`},
+	{"Int", IntFrom(123456), `123456`},
+	{"Int32", Int32From(123456), `123456`},
+	{"Int16", Int16From(1234), `1234`},
+	{"Int8", Int8From(12), `12`},
+	{"Byte", ByteFrom('a'), `97`},
+	{"Uint", UintFrom(123456), `123456`},
+	{"Uint32", Uint32From(123456), `123456`},
+	{"Uint16", Uint16From(1234), `1234`},
+	{"Uint8", Uint8From(12), `12`},
+	{"Float", FloatFrom(1.25), `1.25`},
+	{"Float32", Float32From(1.25), `1.25`},
+	{"BigInt", BigIntFrom(big.NewInt(123456)), `123456`},
+	{"Bool", BoolFrom(true), `true`},
+	{"Time", TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)), `"2012-12-21T21:21:21Z"`},
+	{"Date", DateFrom(2012, time.December, 21), `"2012-12-21"`},
+	{"Duration", DurationFrom(90 * time.Second), `"1m30s"`},
+	{"JSON", JSONFrom([]byte(`{"a":1}`)), `{"a":1}`},
+	{"Bytes", BytesFrom([]byte("hello")), `"aGVsbG8="`},
+	{"UUID", UUIDFrom([16]byte{15: 1}), `"00000000-0000-0000-0000-000000000001"`},
+	{"Addr", AddrFrom(netip.MustParseAddr("192.0.2.1")), `"192.0.2.1"`},
+	{"Prefix", PrefixFrom(netip.MustParsePrefix("192.0.2.0/24")), `"192.0.2.0/24"`},
+	{"AddrPort", AddrPortFrom(netip.MustParseAddrPort("192.0.2.1:80")), `"192.0.2.1:80"`},
+	{"Value", ValueFrom(123456), `123456`},
+}
+
+func BenchmarkTypes(b *testing.B) {
+	for _, bt := range benchTypes {
+		// String has no MarshalJSON, so go through encoding/json for every type
+		b.Run(bt.name+"/Marshal", func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				json.Marshal(bt.v)
+			}
+		})
+		b.Run(bt.name+"/AppendJSON", func(b *testing.B) {
+			a := bt.v.(interface {
+				AppendJSON([]byte) ([]byte, error)
+			})
+			buf := make([]byte, 0, 64)
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				a.AppendJSON(buf[:0])
+			}
+		})
+		for _, input := range []string{bt.json, "null"} {
+			name := bt.name + "/UnmarshalJSON"
+			if input == "null" {
+				name += "/null"
+			}
+			b.Run(name, func(b *testing.B) {
+				u := reflect.New(reflect.TypeOf(bt.v)).Interface().(json.Unmarshaler)
+				data := []byte(input)
+				b.ReportAllocs()
+				for n := 0; n < b.N; n++ {
+					u.UnmarshalJSON(data)
+				}
+			})
+		}
+	}
+}
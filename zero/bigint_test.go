@@ -0,0 +1,144 @@
+package zero
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+var (
+	bigIntString = "115792089237316195423570985008687907853269984665640564039457584007913129639935" // 2^256 - 1
+	bigIntJSON   = []byte(bigIntString)
+	bigIntValue  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+func TestBigIntFrom(t *testing.T) {
+	assertBigInt(t, BigIntFrom(bigIntValue), "BigIntFrom()")
+	assertBigInt(t, BigIntFromPtr(bigIntValue), "BigIntFromPtr()")
+	assertNullBigInt(t, BigIntFromPtr(nil), "BigIntFromPtr(nil)")
+	assertNullBigInt(t, BigIntFrom(new(big.Int)), "BigIntFrom(0)")
+}
+
+func TestBigIntScanValue(t *testing.T) {
+	var b BigInt
+	err := b.Scan(bigIntString)
+	maybePanic(err)
+	assertBigInt(t, b, "scanned")
+	v, err := b.Value()
+	maybePanic(err)
+	if v != bigIntString {
+		t.Errorf("bad value: %#v", v)
+	}
+
+	var zero BigInt
+	maybePanic(zero.Scan(int64(0)))
+	if !zero.Valid {
+		t.Error("scanned 0 should be valid")
+	}
+
+	var null BigInt
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullBigInt(t, null, "scanned null")
+	if v, err := null.Value(); v != nil || err != nil {
+		t.Errorf("null value: %#v %v", v, err)
+	}
+
+	var bad BigInt
+	if err := bad.Scan("1.5"); err == nil {
+		t.Error("expected error: scanning 1.5")
+	}
+	assertNullBigInt(t, bad, "bad scan")
+}
+
+func TestBigIntJSON(t *testing.T) {
+	var b BigInt
+	err := json.Unmarshal(bigIntJSON, &b)
+	maybePanic(err)
+	assertBigInt(t, b, "number json")
+
+	var str BigInt
+	err = json.Unmarshal([]byte(`"`+bigIntString+`"`), &str)
+	maybePanic(err)
+	assertBigInt(t, str, "string json")
+
+	var zero BigInt
+	err = json.Unmarshal([]byte(`0`), &zero)
+	maybePanic(err)
+	assertNullBigInt(t, zero, "zero json")
+
+	var null BigInt
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullBigInt(t, null, "null json")
+
+	if err := json.Unmarshal(invalidJSON, &null); err == nil {
+		t.Error("expected error: invalid json")
+	}
+
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, bigIntString, "number json marshal")
+	data, err = json.Marshal(BigInt{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+
+	data, err = json.Marshal(BigIntString{b})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+bigIntString+`"`, "string json marshal")
+	data, err = json.Marshal(BigIntString{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0"`, "null string json marshal")
+}
+
+func TestBigIntText(t *testing.T) {
+	var b BigInt
+	err := b.UnmarshalText([]byte(bigIntString))
+	maybePanic(err)
+	assertBigInt(t, b, "text")
+
+	data, err := BigInt{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+
+	var zero BigInt
+	err = zero.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertNullBigInt(t, zero, "zero text")
+}
+
+func TestBigIntCompare(t *testing.T) {
+	zero, one := BigIntFrom(new(big.Int)), BigIntFrom(big.NewInt(1))
+	if !zero.Equal(BigInt{}) || !(BigInt{Valid: true}).Equal(BigInt{}) {
+		t.Error("zero should equal null")
+	}
+	if one.Equal(BigInt{}) {
+		t.Error("1 should not equal null")
+	}
+	if got := (BigInt{}).Compare(one); got != -1 {
+		t.Error("null vs 1:", got)
+	}
+	if got := BigIntFrom(big.NewInt(-1)).Compare(BigInt{}); got != -1 {
+		t.Error("-1 vs null:", got)
+	}
+	if !(BigInt{}).IsZero() || !zero.IsZero() || one.IsZero() {
+		t.Error("bad IsZero")
+	}
+}
+
+func assertBigInt(t *testing.T, b BigInt, from string) {
+	t.Helper()
+	if !b.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+	if b.Int.Cmp(bigIntValue) != 0 {
+		t.Errorf("bad %s big int: %v ≠ %v\n", from, b.Int, bigIntValue)
+	}
+}
+
+func assertNullBigInt(t *testing.T, b BigInt, from string) {
+	t.Helper()
+	if b.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,765 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// The types in this package implement encoding.BinaryMarshaler and encoding.BinaryUnmarshaler,
+// as well as gob.GobEncoder and gob.GobDecoder, using the same format as package null.
+// Null and zero values are encoded as null, and decode to null.
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this String is null or zero.
+func (s String) MarshalBinary() ([]byte, error) {
+	b := internal.AppendBinaryHeader(make([]byte, 0, 1+len(s.String)), !s.IsZero())
+	if s.IsZero() {
+		return b, nil
+	}
+	return append(b, s.String...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// A blank string will be considered a null String.
+func (s *String) UnmarshalBinary(data []byte) error {
+	payload, _, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	s.String = string(payload)
+	s.Valid = s.String != ""
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (s String) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (s *String) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Int is null or zero.
+func (i Int) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Int64, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Int.
+func (i *Int) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Int64, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Int) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Int) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Int32 is null or zero.
+func (i Int32) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Int32, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Int32.
+func (i *Int32) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Int32, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Int32 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Int32) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Int32) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Int16 is null or zero.
+func (i Int16) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Int16, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Int16.
+func (i *Int16) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Int16, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Int16 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Int16) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Int16) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Int8 is null or zero.
+func (i Int8) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Int8, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Int8.
+func (i *Int8) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Int8, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Int8) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Int8) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Byte is null or zero.
+func (b Byte) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(b.Byte, !b.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Byte.
+func (b *Byte) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &b.Byte, &b.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	b.Valid = b.Byte != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (b Byte) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (b *Byte) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Uint is null or zero.
+func (i Uint) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Uint64, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Uint.
+func (i *Uint) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Uint64, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Uint64 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Uint) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Uint) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Uint32 is null or zero.
+func (i Uint32) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Uint32, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Uint32.
+func (i *Uint32) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Uint32, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Uint32 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Uint32) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Uint32) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Uint16 is null or zero.
+func (i Uint16) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Uint16, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Uint16.
+func (i *Uint16) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Uint16, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Uint16 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Uint16) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Uint16) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Uint8 is null or zero.
+func (i Uint8) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryInt(i.Uint8, !i.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Uint8.
+func (i *Uint8) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryInt(data, &i.Uint8, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	i.Valid = i.Uint8 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (i Uint8) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (i *Uint8) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Float is null or zero.
+func (f Float) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryFloat(f.Float64, !f.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Float.
+func (f *Float) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryFloat(data, &f.Float64, &f.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (f Float) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (f *Float) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Float32 is null or zero.
+func (f Float32) MarshalBinary() ([]byte, error) {
+	return internal.MarshalBinaryFloat(f.Float32, !f.IsZero()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Float32.
+func (f *Float32) UnmarshalBinary(data []byte) error {
+	if err := internal.UnmarshalBinaryFloat(data, &f.Float32, &f.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	f.Valid = f.Float32 != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (f Float32) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (f *Float32) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this BigInt is null or zero.
+func (b BigInt) MarshalBinary() ([]byte, error) {
+	valid := !b.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 2+(b.value().BitLen()+7)/8), valid)
+	if !valid {
+		return buf, nil
+	}
+	return internal.AppendBinaryBigInt(buf, b.value()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null BigInt.
+func (b *BigInt) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		b.Int, b.Valid = nil, false
+		return nil
+	}
+	i, err := internal.ParseBinaryBigInt(payload)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	b.Int, b.Valid = i, i.Sign() != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (b BigInt) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (b *BigInt) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Bool is null or zero.
+func (b Bool) MarshalBinary() ([]byte, error) {
+	valid := !b.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 2), valid)
+	if !valid {
+		return buf, nil
+	}
+	return internal.AppendBinaryBool(buf, b.Bool), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// false will be considered a null Bool.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		b.Bool, b.Valid = false, false
+		return nil
+	}
+	v, err := internal.ParseBinaryBool(payload)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	b.Bool, b.Valid = v, v
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (b Bool) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (b *Bool) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Time is null or zero.
+func (t Time) MarshalBinary() ([]byte, error) {
+	valid := !t.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 1+3*binary.MaxVarintLen64), valid)
+	if !valid {
+		return buf, nil
+	}
+	return internal.AppendBinaryTime(buf, t.Time), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// The zero time will be considered a null Time.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	v, err := internal.ParseBinaryTime(payload)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	t.Time, t.Valid = v, !v.IsZero()
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Date is null or zero.
+func (d Date) MarshalBinary() ([]byte, error) {
+	valid := !d.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 1+binary.MaxVarintLen64+2), valid)
+	if !valid {
+		return buf, nil
+	}
+	return internal.AppendBinaryDate(buf, d.Year, d.Month, d.Day), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// The zero date (0001-01-01) will be considered a null Date.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		*d = Date{}
+		return nil
+	}
+	year, month, day, err := internal.ParseBinaryDate(payload)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	*d = NewDate(year, month, day, !isZeroDate(year, month, day))
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (d Date) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (d *Date) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Duration is null or zero.
+func (d Duration) MarshalBinary() ([]byte, error) {
+	valid := !d.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 1+binary.MaxVarintLen64), valid)
+	if !valid {
+		return buf, nil
+	}
+	return internal.AppendBinaryInt(buf, int64(d.Duration)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// 0 will be considered a null Duration.
+func (d *Duration) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	n, err := internal.ParseBinaryInt[int64](payload)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	d.Duration, d.Valid = time.Duration(n), n != 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (d Duration) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (d *Duration) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this JSON is null or zero.
+func (j JSON) MarshalBinary() ([]byte, error) {
+	valid := !j.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 1+len(j.JSON)), valid)
+	if !valid {
+		return buf, nil
+	}
+	return append(buf, j.JSON...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// Empty input or a JSON null will be considered a null JSON.
+func (j *JSON) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	j.JSON, j.Valid = bytes.Clone(payload), !isNullJSON(payload)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (j JSON) GobEncode() ([]byte, error) {
+	return j.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (j *JSON) GobDecode(data []byte) error {
+	return j.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Bytes is null or zero.
+func (b Bytes) MarshalBinary() ([]byte, error) {
+	valid := !b.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 1+len(b.Bytes)), valid)
+	if !valid {
+		return buf, nil
+	}
+	return append(buf, b.Bytes...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// An empty payload will be considered a null Bytes.
+func (b *Bytes) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	b.Bytes, b.Valid = bytes.Clone(payload), len(payload) > 0
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (b Bytes) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (b *Bytes) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this UUID is null or zero.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	valid := !u.IsZero()
+	buf := internal.AppendBinaryHeader(make([]byte, 0, 17), valid)
+	if !valid {
+		return buf, nil
+	}
+	return append(buf, u.UUID[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// The nil UUID will be considered a null UUID.
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	if len(payload) != len(u.UUID) {
+		return fmt.Errorf("zero: couldn't unmarshal binary: invalid UUID length %d", len(payload))
+	}
+	u.UUID = [16]byte(payload)
+	u.Valid = u.UUID != [16]byte{}
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (u UUID) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (u *UUID) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Addr is null or zero.
+func (a Addr) MarshalBinary() ([]byte, error) {
+	buf := internal.AppendBinaryHeader(nil, !a.IsZero())
+	if a.IsZero() {
+		return buf, nil
+	}
+	b, err := a.Addr.MarshalBinary()
+	return append(buf, b...), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// An invalid address will be considered a null Addr.
+func (a *Addr) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return nil
+	}
+	var v netip.Addr
+	if err := v.UnmarshalBinary(payload); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	a.Addr, a.Valid = v, v.IsValid()
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (a Addr) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (a *Addr) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Prefix is null or zero.
+func (p Prefix) MarshalBinary() ([]byte, error) {
+	buf := internal.AppendBinaryHeader(nil, !p.IsZero())
+	if p.IsZero() {
+		return buf, nil
+	}
+	b, err := p.Prefix.MarshalBinary()
+	return append(buf, b...), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// An invalid prefix will be considered a null Prefix.
+func (p *Prefix) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return nil
+	}
+	var v netip.Prefix
+	if err := v.UnmarshalBinary(payload); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	p.Prefix, p.Valid = v, v.IsValid()
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (p Prefix) GobEncode() ([]byte, error) {
+	return p.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (p *Prefix) GobDecode(data []byte) error {
+	return p.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this AddrPort is null or zero.
+func (ap AddrPort) MarshalBinary() ([]byte, error) {
+	buf := internal.AppendBinaryHeader(nil, !ap.IsZero())
+	if ap.IsZero() {
+		return buf, nil
+	}
+	b, err := ap.AddrPort.MarshalBinary()
+	return append(buf, b...), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// An invalid address and port will be considered a null AddrPort.
+func (ap *AddrPort) UnmarshalBinary(data []byte) error {
+	payload, valid, err := internal.ReadBinary(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	if !valid {
+		ap.AddrPort, ap.Valid = netip.AddrPort{}, false
+		return nil
+	}
+	var v netip.AddrPort
+	if err := v.UnmarshalBinary(payload); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	ap.AddrPort, ap.Valid = v, v.IsValid()
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (ap AddrPort) GobEncode() ([]byte, error) {
+	return ap.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (ap *AddrPort) GobDecode(data []byte) error {
+	return ap.UnmarshalBinary(data)
+}
@@ -0,0 +1,149 @@
+package zero
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// binaryTypes is every type in this package, which should all support encoding.BinaryMarshaler and gob.
+var binaryTypes = []any{
+	String{}, Int{}, Int32{}, Int16{}, Int8{}, Byte{}, Uint{}, Uint32{}, Uint16{}, Uint8{},
+	Float{}, Float32{}, BigInt{}, Bool{}, Time{}, Date{}, Duration{},
+	JSON{}, Bytes{}, UUID{}, Addr{}, Prefix{}, AddrPort{}, Value[int]{},
+}
+
+func TestBinaryInterfaces(t *testing.T) {
+	for _, v := range binaryTypes {
+		if _, ok := v.(encoding.BinaryMarshaler); !ok {
+			t.Errorf("%T doesn't implement encoding.BinaryMarshaler", v)
+		}
+		if _, ok := v.(gob.GobEncoder); !ok {
+			t.Errorf("%T doesn't implement gob.GobEncoder", v)
+		}
+		ptr := reflect.New(reflect.TypeOf(v)).Interface()
+		if _, ok := ptr.(encoding.BinaryUnmarshaler); !ok {
+			t.Errorf("%T doesn't implement encoding.BinaryUnmarshaler", v)
+		}
+		if _, ok := ptr.(gob.GobDecoder); !ok {
+			t.Errorf("%T doesn't implement gob.GobDecoder", v)
+		}
+	}
+}
+
+type binaryRecord struct {
+	ID     Int
+	Name   String
+	Score  Float32
+	Big    BigInt
+	Active Bool
+	Born   Date
+	Seen   Time
+	Wait   Duration
+	Raw    Bytes
+	UUID   UUID
+	IP     Addr
+	Count  Value[int]
+}
+
+func TestBinaryZero(t *testing.T) {
+	// zero values are written as null
+	zeros := []encoding.BinaryMarshaler{
+		IntFrom(0), StringFrom(""), FloatFrom(0), BoolFrom(false), TimeFrom(time.Time{}),
+		DateFrom(1, time.January, 1), DurationFrom(0), BytesFrom([]byte{}), JSONFrom([]byte("null")),
+		UUIDFrom([16]byte{}), AddrFrom(netip.Addr{}), BigIntFrom(big.NewInt(0)), ValueFrom(0),
+	}
+	for _, v := range zeros {
+		data, err := v.MarshalBinary()
+		maybePanic(err)
+		if !bytes.Equal(data, []byte{0x10}) {
+			t.Errorf("%T: zero marshal: %#x ≠ 0x10", v, data)
+		}
+	}
+
+	// valid zero values written by package null decode as null
+	valid := []struct {
+		v    encoding.BinaryUnmarshaler
+		data []byte
+	}{
+		{&Int{}, []byte{0x11, 0x00}},
+		{&String{}, []byte{0x11}},
+		{&Float{}, []byte{0x11, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{&Bool{}, []byte{0x11, 0x00}},
+		{&Date{}, []byte{0x11, 0x02, 0x01, 0x01}},
+		{&Bytes{}, []byte{0x11}},
+		{&JSON{}, []byte{0x11, 'n', 'u', 'l', 'l'}},
+		{&BigInt{}, []byte{0x11, 0x00}},
+		{&Value[int]{}, []byte{0x11, '0'}},
+	}
+	for _, test := range valid {
+		maybePanic(test.v.UnmarshalBinary(test.data))
+		if !reflect.ValueOf(test.v).MethodByName("IsZero").Call(nil)[0].Bool() ||
+			reflect.ValueOf(test.v).Elem().FieldByName("Valid").Bool() {
+			t.Errorf("%T: zero value should unmarshal as null: %+v", test.v, test.v)
+		}
+	}
+}
+
+func TestBinaryGobRoundTrip(t *testing.T) {
+	in := binaryRecord{
+		ID:     IntFrom(-1),
+		Name:   StringFrom("<test>"),
+		Score:  Float32From(0.1),
+		Big:    BigIntFrom(big.NewInt(-2)),
+		Active: BoolFrom(true),
+		Born:   DateFrom(2000, time.January, 2),
+		Seen:   TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)),
+		Wait:   DurationFrom(90 * time.Second),
+		Raw:    BytesFrom([]byte("hi")),
+		IP:     AddrFrom(netip.MustParseAddr("192.0.2.1")),
+		Count:  ValueFrom(3),
+	}
+	var buf bytes.Buffer
+	maybePanic(gob.NewEncoder(&buf).Encode(in))
+
+	var out binaryRecord
+	maybePanic(gob.NewDecoder(&buf).Decode(&out))
+	if !out.ID.Equal(in.ID) || !out.Name.Equal(in.Name) || !out.Score.Equal(in.Score) ||
+		!out.Big.Equal(in.Big) || !out.Active.Equal(in.Active) || !out.Born.Equal(in.Born) ||
+		!out.Seen.Equal(in.Seen) || !out.Wait.Equal(in.Wait) || !out.Raw.Equal(in.Raw) ||
+		!out.UUID.Equal(in.UUID) || out.UUID.Valid || !out.IP.Equal(in.IP) || !out.Count.Equal(in.Count) {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+}
+
+func TestBinaryFormat(t *testing.T) {
+	// the format is shared with package null
+	table := []struct {
+		v    encoding.BinaryMarshaler
+		want []byte
+	}{
+		{IntFrom(-1), []byte{0x11, 0x01}},
+		{Uint8From(200), []byte{0x11, 0xc8, 0x01}},
+		{Float32From(1), []byte{0x11, 0x3f, 0x80, 0, 0}},
+		{BoolFrom(true), []byte{0x11, 0x01}},
+		{StringFrom("hi"), []byte{0x11, 'h', 'i'}},
+		{DateFrom(2000, time.January, 2), []byte{0x11, 0xa0, 0x1f, 0x01, 0x02}},
+		{DurationFrom(-1), []byte{0x11, 0x01}},
+		{ValueFrom("x"), []byte{0x11, '"', 'x', '"'}},
+	}
+	for _, test := range table {
+		data, err := test.v.MarshalBinary()
+		maybePanic(err)
+		if !bytes.Equal(data, test.want) {
+			t.Errorf("%T %v: %#x ≠ %#x", test.v, test.v, data, test.want)
+		}
+	}
+
+	var i Int
+	for _, data := range [][]byte{nil, {0x21}, {0x12}, {0x10, 0x00}, {0x11, 0x80}} {
+		if err := i.UnmarshalBinary(data); err == nil {
+			t.Errorf("expected error for %#x", data)
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 // Bool is a nullable bool. False input is considered null.
@@ -58,7 +61,9 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &b.Bool); err != nil {
+	if v, ok := internal.ParseJSONBool(data); ok {
+		b.Bool = v
+	} else if err := json.Unmarshal(data, &b.Bool); err != nil {
 		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
 	}
 
@@ -90,19 +95,25 @@ func (b *Bool) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
 func (b Bool) MarshalJSON() ([]byte, error) {
-	if !b.Valid || !b.Bool {
-		return []byte("false"), nil
-	}
-	return []byte("true"), nil
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Bool to dst, as MarshalJSON does.
+// It will append false if this Bool is null.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendBool(dst, b.Valid && b.Bool), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
-	if !b.Valid || !b.Bool {
-		return []byte("false"), nil
-	}
-	return []byte("true"), nil
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append false if this Bool is null.
+func (b Bool) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendBool(dst, b.Valid && b.Bool), nil
 }
 
 // SetValid changes this Bool's value and also sets it to be non-null.
@@ -128,3 +139,68 @@ func (b Bool) IsZero() bool {
 func (b Bool) Equal(other Bool) bool {
 	return b.ValueOrZero() == other.ValueOrZero()
 }
+
+// Compare returns an integer comparing two Bools, following the conventions of cmp.Compare.
+// false is less than true, and null is considered equal to false.
+func (b Bool) Compare(other Bool) int {
+	return internal.CompareBool(b.ValueOrZero(), other.ValueOrZero())
+}
+
+// And returns the logical conjunction of b and other.
+// Because this package considers null and false to be the same,
+// this is ordinary two-valued logic: null operands are treated as false.
+// Note that this differs from SQL, where NULL AND TRUE is NULL;
+// here it is false, which is itself null.
+func (b Bool) And(other Bool) Bool {
+	return BoolFrom(b.ValueOrZero() && other.ValueOrZero())
+}
+
+// Or returns the logical disjunction of b and other.
+// Null operands are treated as false.
+// SQL would give NULL OR FALSE as NULL; here it is false, which this type also considers null.
+func (b Bool) Or(other Bool) Bool {
+	return BoolFrom(b.ValueOrZero() || other.ValueOrZero())
+}
+
+// Not returns the logical negation of b.
+// Null is treated as false, so the negation of null is true.
+// This differs from SQL, where NOT NULL is NULL.
+func (b Bool) Not() Bool {
+	return BoolFrom(!b.ValueOrZero())
+}
+
+// Xor returns the exclusive disjunction of b and other.
+// Null operands are treated as false, whereas in SQL the result would be NULL.
+func (b Bool) Xor(other Bool) Bool {
+	return BoolFrom(b.ValueOrZero() != other.ValueOrZero())
+}
+
+// Implies returns the material implication of b and other, equivalent to (NOT b) OR other.
+// Null operands are treated as false, so a null b always implies true.
+func (b Bool) Implies(other Bool) Bool {
+	return b.Not().Or(other)
+}
+
+// AllBool returns true if every Bool is true.
+// Null values are treated as false.
+// AllBool of no arguments is true.
+func AllBool(bs ...Bool) Bool {
+	for _, b := range bs {
+		if !b.ValueOrZero() {
+			return BoolFrom(false)
+		}
+	}
+	return BoolFrom(true)
+}
+
+// AnyBool returns true if any Bool is true.
+// Null values are treated as false.
+// AnyBool of no arguments is false.
+func AnyBool(bs ...Bool) Bool {
+	for _, b := range bs {
+		if b.ValueOrZero() {
+			return BoolFrom(true)
+		}
+	}
+	return BoolFrom(false)
+}
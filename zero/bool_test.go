@@ -228,6 +228,45 @@ func TestBoolEqual(t *testing.T) {
 	assertBoolEqualIsFalse(t, b1, b2)
 }
 
+func TestBoolLogic(t *testing.T) {
+	T, F, N := BoolFrom(true), NewBool(false, true), Bool{}
+	table := []struct {
+		a, b                  Bool
+		and, or, xor, implies bool
+	}{
+		{T, T, true, true, false, true},
+		{T, F, false, true, true, false},
+		{T, N, false, true, true, false},
+		{F, N, false, false, false, true},
+		{N, T, false, true, true, true},
+		{N, N, false, false, false, true},
+	}
+	for _, tc := range table {
+		assertBoolValue(t, tc.a.And(tc.b), tc.and, "AND")
+		assertBoolValue(t, tc.a.Or(tc.b), tc.or, "OR")
+		assertBoolValue(t, tc.a.Xor(tc.b), tc.xor, "XOR")
+		assertBoolValue(t, tc.a.Implies(tc.b), tc.implies, "IMPLIES")
+	}
+
+	assertBoolValue(t, T.Not(), false, "NOT true")
+	assertBoolValue(t, N.Not(), true, "NOT null")
+
+	assertBoolValue(t, AllBool(), true, "AllBool()")
+	assertBoolValue(t, AllBool(T, N), false, "AllBool(true, null)")
+	assertBoolValue(t, AnyBool(), false, "AnyBool()")
+	assertBoolValue(t, AnyBool(N, T), true, "AnyBool(null, true)")
+
+	// false results are null
+	assertNullBool(t, T.And(N), "true AND null")
+}
+
+func assertBoolValue(t *testing.T, got Bool, want bool, from string) {
+	t.Helper()
+	if got.ValueOrZero() != want {
+		t.Errorf("bad %s: got %v, want %v", from, got.ValueOrZero(), want)
+	}
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
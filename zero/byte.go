@@ -1,7 +1,9 @@
 package zero
 
 import (
+	"cmp"
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/guregu/null/v6/internal"
@@ -54,6 +56,16 @@ func (b Byte) ValueOr(v byte) byte {
 	return b.Byte
 }
 
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range instead of truncating it.
+func (b *Byte) Scan(src any) error {
+	if err := internal.ScanInt(src, &b.Byte, &b.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't scan Byte: %w", err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Byte.
@@ -81,21 +93,25 @@ func (b *Byte) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode 0 if this Byte is null.
 func (b Byte) MarshalJSON() ([]byte, error) {
-	n := b.Byte
-	if !b.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Byte to dst, as MarshalJSON does.
+// It will append 0 if this Byte is null.
+func (b Byte) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(b.ValueOrZero()), 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Byte is null.
 func (b Byte) MarshalText() ([]byte, error) {
-	n := b.Byte
-	if !b.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Byte is null.
+func (b Byte) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(b.ValueOrZero()), 10), nil
 }
 
 // SetValid changes this Byte's value and also sets it to be non-null.
@@ -122,6 +138,12 @@ func (b Byte) Equal(other Byte) bool {
 	return b.ValueOrZero() == other.ValueOrZero()
 }
 
+// Compare returns an integer comparing two Bytes, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+func (b Byte) Compare(other Byte) int {
+	return cmp.Compare(b.ValueOrZero(), other.ValueOrZero())
+}
+
 func (b Byte) value() (int64, bool) {
 	return int64(b.Byte), b.Valid
 }
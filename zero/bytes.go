@@ -0,0 +1,197 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Bytes is a nullable []byte, such as a BYTEA or BLOB column.
+// JSON marshals to a blank string if null.
+// Null values and empty slices are considered equivalent.
+type Bytes struct {
+	Bytes []byte
+	Valid bool // Valid is true if Bytes is not NULL
+}
+
+// NewBytes creates a new Bytes.
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// BytesFrom creates a new Bytes that will be null if b is empty.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, len(b) != 0)
+}
+
+// BytesFromPtr creates a new Bytes that will be null if b is nil or empty.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return BytesFrom(*b)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (b Bytes) ValueOr(v []byte) []byte {
+	if !b.Valid {
+		return v
+	}
+	return b.Bytes
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts []byte and string values.
+// The data is copied, because drivers may reuse their buffers.
+// An empty value produces a null Bytes.
+func (b *Bytes) Scan(src any) error {
+	switch x := src.(type) {
+	case nil:
+		b.Bytes, b.Valid = nil, false
+		return nil
+	case []byte:
+		*b = BytesFrom(bytes.Clone(x))
+		return nil
+	case string:
+		*b = BytesFrom([]byte(x))
+		return nil
+	}
+	b.Bytes, b.Valid = nil, false
+	return fmt.Errorf("zero: couldn't scan Bytes: unsupported type %T", src)
+}
+
+// Value implements the driver Valuer interface.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	if b.Bytes == nil {
+		// a nil []byte would be sent as NULL
+		return []byte{}, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64 string and null input.
+// An empty string will be considered a null Bytes.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var v []byte
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	*b = BytesFrom(v)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It decodes base64 text, and will unmarshal to a null Bytes if the input is blank.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	v, err := internal.DecodeBytes(text, internal.BytesBase64)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	*b = BytesFrom(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a blank string if this Bytes is null, otherwise a base64 string.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Bytes to dst, as MarshalJSON does.
+// It will append a blank string if this Bytes is null.
+func (b Bytes) AppendJSON(dst []byte) ([]byte, error) {
+	dst = append(dst, '"')
+	dst, _ = internal.AppendBytes(dst, b.ValueOrZero(), internal.BytesBase64)
+	return append(dst, '"'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Bytes is null, otherwise a base64 string.
+func (b Bytes) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this Bytes is null.
+func (b Bytes) AppendText(dst []byte) ([]byte, error) {
+	return internal.AppendBytes(dst, b.ValueOrZero(), internal.BytesBase64)
+}
+
+// SetValid changes this Bytes's value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// IsZero returns true for null or empty Bytes, for potential future omitempty support.
+func (b Bytes) IsZero() bool {
+	return !b.Valid || len(b.Bytes) == 0
+}
+
+// Equal returns true if both Bytes have the same value or are both either null or empty.
+// Values are compared with bytes.Equal.
+func (b Bytes) Equal(other Bytes) bool {
+	return bytes.Equal(b.ValueOrZero(), other.ValueOrZero())
+}
+
+// Compare returns an integer comparing two Bytes lexicographically, following the conventions of cmp.Compare.
+// Null is considered equal to an empty slice.
+func (b Bytes) Compare(other Bytes) int {
+	return bytes.Compare(b.ValueOrZero(), other.ValueOrZero())
+}
+
+// BytesHex is a Bytes that uses lowercase hexadecimal for text, instead of base64.
+// JSON still uses base64, like encoding/json does for []byte.
+// It is otherwise the same as Bytes, and can be made from one with BytesHex{b}.
+type BytesHex struct {
+	Bytes
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It decodes hex text, and will unmarshal to a null BytesHex if the input is blank.
+func (b *BytesHex) UnmarshalText(text []byte) error {
+	v, err := internal.DecodeBytes(text, internal.BytesHex)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	b.Bytes = BytesFrom(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this BytesHex is null, otherwise a hex string.
+func (b BytesHex) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this BytesHex is null.
+func (b BytesHex) AppendText(dst []byte) ([]byte, error) {
+	return internal.AppendBytes(dst, b.ValueOrZero(), internal.BytesHex)
+}
@@ -0,0 +1,74 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	value := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	for _, b := range [][]byte{nil, {}} {
+		if BytesFrom(b).Valid {
+			t.Errorf("BytesFrom(%#v) should be null", b)
+		}
+		var scanned Bytes
+		err := scanned.Scan(b)
+		maybePanic(err)
+		if scanned.Valid {
+			t.Errorf("scanning %#v should be null", b)
+		}
+	}
+
+	src := bytes.Clone(value)
+	var b Bytes
+	err := b.Scan(src)
+	maybePanic(err)
+	src[0] = 0
+	if !b.Valid || !bytes.Equal(b.Bytes, value) {
+		t.Errorf("bad scan: %#v", b)
+	}
+
+	data, err := json.Marshal(Bytes{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "null json marshal")
+	data, err = json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"3q2+7w=="`, "json marshal")
+
+	for _, data := range []string{`null`, `""`} {
+		var null Bytes
+		err := json.Unmarshal([]byte(data), &null)
+		maybePanic(err)
+		if null.Valid {
+			t.Errorf("%s should unmarshal to null", data)
+		}
+	}
+
+	data, err = b.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "3q2+7w==", "text marshal")
+	data, err = BytesHex{b}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "deadbeef", "hex text marshal")
+	var hb BytesHex
+	err = hb.UnmarshalText([]byte("deadbeef"))
+	maybePanic(err)
+	if !hb.Equal(b) {
+		t.Errorf("bad hex text unmarshal: %#v", hb)
+	}
+	var text BytesHex
+	err = text.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if text.Valid {
+		t.Error("blank text should be null")
+	}
+
+	if !(Bytes{}).Equal(NewBytes([]byte{}, true)) {
+		t.Error("null should equal empty")
+	}
+	if c := (Bytes{}).Compare(b); c != -1 {
+		t.Errorf("null vs value: %d", c)
+	}
+}
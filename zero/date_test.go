@@ -0,0 +1,134 @@
+package zero
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	dateString   = "2024-05-01"
+	dateJSON     = []byte(`"` + dateString + `"`)
+	zeroDateJSON = []byte(`"0001-01-01"`)
+	dateValue    = DateFrom(2024, time.May, 1)
+)
+
+func TestDateFrom(t *testing.T) {
+	assertDate(t, dateValue, "DateFrom()")
+	assertNullDate(t, DateFrom(1, time.January, 1), "DateFrom(zero date)")
+	assertNullDate(t, DateFrom(0, 0, 0), "DateFrom(0, 0, 0)")
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	assertDate(t, DateOf(time.Date(2024, time.May, 1, 8, 0, 0, 0, tokyo)), "DateOf() tokyo")
+	assertNullDate(t, DateOf(time.Time{}), "DateOf(zero time)")
+
+	assertNullDate(t, DateFromPtr(nil), "DateFromPtr(nil)")
+	zero := time.Time{}
+	assertNullDate(t, DateFromPtr(&zero), "DateFromPtr(zero time)")
+}
+
+func TestUnmarshalDateJSON(t *testing.T) {
+	var d Date
+	err := json.Unmarshal(dateJSON, &d)
+	maybePanic(err)
+	assertDate(t, d, "UnmarshalJSON() json")
+
+	for _, data := range [][]byte{nullJSON, zeroDateJSON, []byte(`""`)} {
+		var null Date
+		err = json.Unmarshal(data, &null)
+		maybePanic(err)
+		assertNullDate(t, null, string(data))
+	}
+
+	var invalid Date
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullDate(t, invalid, "invalid json")
+
+	var badDay Date
+	if err := json.Unmarshal([]byte(`"2024-02-30"`), &badDay); err == nil {
+		t.Error("expected error: bad day")
+	}
+}
+
+func TestMarshalDate(t *testing.T) {
+	data, err := json.Marshal(dateValue)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(dateJSON), "non-empty json marshal")
+
+	data, err = json.Marshal(Date{})
+	maybePanic(err)
+	assertJSONEquals(t, data, string(zeroDateJSON), "null json marshal")
+
+	data, err = NewDate(2024, time.May, 1, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0001-01-01", "null text marshal")
+
+	var d Date
+	err = d.UnmarshalText([]byte(dateString))
+	maybePanic(err)
+	assertDate(t, d, "UnmarshalText()")
+}
+
+func TestDateScanValue(t *testing.T) {
+	var d Date
+	err := d.Scan(time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC))
+	maybePanic(err)
+	assertDate(t, d, "scanned time")
+
+	var zero Date
+	err = zero.Scan("0001-01-01")
+	maybePanic(err)
+	assertNullDate(t, zero, "scanned zero date")
+
+	v, err := zero.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("zero Value() should be nil, got %v", v)
+	}
+	v, err = NewDate(1, time.January, 1, true).Value()
+	maybePanic(err)
+	if v != (time.Time{}) {
+		t.Errorf("valid zero date Value() should be the zero time, got %v", v)
+	}
+}
+
+func TestDateCompare(t *testing.T) {
+	if !(Date{}).Equal(NewDate(1, time.January, 1, true)) {
+		t.Error("null should equal the zero date")
+	}
+	if !NewDate(0, 0, 0, true).IsZero() {
+		t.Error("all-zero date should be zero")
+	}
+	if c := dateValue.Compare(Date{}); c != 1 {
+		t.Errorf("date vs null: %d", c)
+	}
+	if !(Date{}).Before(dateValue) {
+		t.Error("null should be before date")
+	}
+	if days := dateValue.AddDays(10).DaysSince(dateValue); days != 10 {
+		t.Errorf("bad DaysSince: %d", days)
+	}
+	assertNullDate(t, Date{}.AddDays(1), "null AddDays")
+}
+
+func assertDate(t *testing.T, d Date, from string) {
+	t.Helper()
+	if d.Year != 2024 || d.Month != time.May || d.Day != 1 {
+		t.Errorf("bad %v date: %d-%d-%d ≠ %s", from, d.Year, d.Month, d.Day, dateString)
+	}
+	if !d.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullDate(t *testing.T, d Date, from string) {
+	t.Helper()
+	if !d.IsZero() {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
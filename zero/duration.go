@@ -0,0 +1,205 @@
+package zero
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Duration is a nullable time.Duration.
+// JSON marshals to "0s" if null.
+// Considered null to SQL if zero.
+type Duration struct {
+	Duration time.Duration
+	Valid    bool // Valid is true if Duration is not NULL
+}
+
+// NewDuration creates a new Duration.
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{
+		Duration: d,
+		Valid:    valid,
+	}
+}
+
+// DurationFrom creates a new Duration that will be null if d is zero.
+func DurationFrom(d time.Duration) Duration {
+	return NewDuration(d, d != 0)
+}
+
+// DurationFromPtr creates a new Duration that be null if d is nil.
+func DurationFromPtr(d *time.Duration) Duration {
+	if d == nil {
+		return NewDuration(0, false)
+	}
+	return DurationFrom(*d)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Duration) ValueOrZero() time.Duration {
+	if !d.Valid {
+		return 0
+	}
+	return d.Duration
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (d Duration) ValueOr(v time.Duration) time.Duration {
+	if !d.Valid {
+		return v
+	}
+	return d.Duration
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integers, which are interpreted as nanoseconds,
+// strings in the format of time.Duration's String method,
+// and Postgres INTERVAL text such as "01:30:00" or "1 day 02:00:00".
+// Days are considered to be 24 hours long, and intervals with years or months are rejected.
+func (d *Duration) Scan(src any) error {
+	if src == nil {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	v, err := internal.ScanDuration(src)
+	if err != nil {
+		d.Duration, d.Valid = 0, false
+		return fmt.Errorf("zero: couldn't scan Duration: %w", err)
+	}
+	d.Duration, d.Valid = v, true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It sends an int64 number of nanoseconds, suitable for BIGINT columns.
+// Use DurationString or DurationInterval to send a string instead.
+func (d Duration) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return int64(d.Duration), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports strings such as "1m30s", numbers of nanoseconds, and null input.
+// 0 will be considered a null Duration.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "null", `""`:
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	v, err := internal.UnmarshalDurationJSON(data)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	d.Duration, d.Valid = v, v != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts strings such as "1m30s", and will unmarshal to a null Duration if the input is blank, "null", or zero.
+func (d *Duration) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	v, err := time.ParseDuration(str)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	d.Duration, d.Valid = v, v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "0s" if this Duration is null, otherwise a string such as "1m30s".
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return d.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Duration to dst, as MarshalJSON does.
+// It will append "0s" if this Duration is null.
+func (d Duration) AppendJSON(dst []byte) ([]byte, error) {
+	dst = append(dst, '"')
+	dst = internal.AppendDuration(dst, d.ValueOrZero())
+	return append(dst, '"'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode "0s" if this Duration is null, otherwise a string such as "1m30s".
+func (d Duration) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append "0s" if this Duration is null.
+func (d Duration) AppendText(dst []byte) ([]byte, error) {
+	return internal.AppendDuration(dst, d.ValueOrZero()), nil
+}
+
+// SetValid changes this Duration's value and also sets it to be non-null.
+func (d *Duration) SetValid(v time.Duration) {
+	d.Duration = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Duration's value, or a nil pointer if this Duration is null.
+func (d Duration) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Duration
+}
+
+// IsZero returns true for null or zero Durations, for future omitempty support (Go 1.4?)
+func (d Duration) IsZero() bool {
+	return !d.Valid || d.Duration == 0
+}
+
+// Equal returns true if both Durations have the same value or are both either null or zero.
+func (d Duration) Equal(other Duration) bool {
+	return d.ValueOrZero() == other.ValueOrZero()
+}
+
+// Compare returns an integer comparing two Durations, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+func (d Duration) Compare(other Duration) int {
+	return cmp.Compare(d.ValueOrZero(), other.ValueOrZero())
+}
+
+// DurationString is a Duration that sends a string such as "1m30s" to the database,
+// the format of time.Duration's String method.
+// It is otherwise the same as Duration, and can be made from one with DurationString{d}.
+type DurationString struct {
+	Duration
+}
+
+// Value implements the driver Valuer interface.
+// It sends a string such as "1m30s", or nil if null.
+func (d DurationString) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Duration.Duration.String(), nil
+}
+
+// DurationInterval is a Duration that sends a string such as "01:30:00" to the database,
+// suitable for Postgres INTERVAL columns.
+// It is otherwise the same as Duration, and can be made from one with DurationInterval{d}.
+type DurationInterval struct {
+	Duration
+}
+
+// Value implements the driver Valuer interface.
+// It sends a string such as "01:30:00", or nil if null.
+func (d DurationInterval) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return internal.FormatInterval(d.Duration.Duration), nil
+}
@@ -0,0 +1,56 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	d := DurationFrom(90 * time.Second)
+	if !d.Valid || d.Duration != 90*time.Second {
+		t.Errorf("bad DurationFrom(): %#v", d)
+	}
+	if DurationFrom(0).Valid {
+		t.Error("DurationFrom(0) should be null")
+	}
+
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1m30s"`, "non-empty json marshal")
+	data, err = json.Marshal(Duration{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0s"`, "null json marshal")
+	data, err = Duration{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0s", "null text marshal")
+
+	for _, data := range []string{`null`, `""`, `"0s"`, `0`} {
+		var null Duration
+		err := json.Unmarshal([]byte(data), &null)
+		maybePanic(err)
+		if !null.IsZero() || null.Valid {
+			t.Errorf("%s should unmarshal to null, got %#v", data, null)
+		}
+	}
+
+	var scanned Duration
+	err = scanned.Scan("1 day 00:00:00")
+	maybePanic(err)
+	if !scanned.Equal(DurationFrom(24 * time.Hour)) {
+		t.Errorf("bad scan: %#v", scanned)
+	}
+
+	v, err := DurationString{d}.Value()
+	maybePanic(err)
+	if v != "1m30s" {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	if !(Duration{}).Equal(NewDuration(0, true)) {
+		t.Error("null should equal zero")
+	}
+	if c := (Duration{}).Compare(DurationFrom(-time.Second)); c != 1 {
+		t.Errorf("null vs -1s: %d", c)
+	}
+}
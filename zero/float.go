@@ -1,11 +1,9 @@
 package zero
 
 import (
+	"cmp"
 	"database/sql"
-	"encoding/json"
 	"fmt"
-	"math"
-	"reflect"
 	"strconv"
 
 	"github.com/guregu/null/v6/internal"
@@ -61,7 +59,7 @@ func (f Float) ValueOr(v float64) float64 {
 // It supports number and null input.
 // 0 will be considered a null Float.
 func (f *Float) UnmarshalJSON(data []byte) error {
-	err := internal.UnmarshalFloatJSON(data, &f.Float64, &f.Valid)
+	err := internal.UnmarshalFloatJSON(data, &f.Float64, &f.Valid, 64)
 	f.Valid = f.Float64 != 0
 	return err
 }
@@ -87,27 +85,25 @@ func (f *Float) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Float is null.
 func (f Float) MarshalJSON() ([]byte, error) {
-	n := f.Float64
-	if !f.Valid {
-		n = 0
-	}
-	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
-		return nil, &json.UnsupportedValueError{
-			Value: reflect.ValueOf(f.Float64),
-			Str:   strconv.FormatFloat(f.Float64, 'g', -1, 64),
-		}
-	}
-	return []byte(strconv.FormatFloat(n, 'f', -1, 64)), nil
+	return f.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Float to dst, as MarshalJSON does.
+// It will append 0 if this Float is null.
+func (f Float) AppendJSON(dst []byte) ([]byte, error) {
+	return internal.AppendFloatJSON(dst, f.ValueOrZero())
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Float is null.
 func (f Float) MarshalText() ([]byte, error) {
-	n := f.Float64
-	if !f.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatFloat(n, 'f', -1, 64)), nil
+	return f.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Float is null.
+func (f Float) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendFloat(dst, f.ValueOrZero(), 'f', -1, 64), nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
@@ -137,3 +133,10 @@ func (f Float) IsZero() bool {
 func (f Float) Equal(other Float) bool {
 	return f.ValueOrZero() == other.ValueOrZero()
 }
+
+// Compare returns an integer comparing two Floats, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+// NaN is less than any number.
+func (f Float) Compare(other Float) int {
+	return cmp.Compare(f.ValueOrZero(), other.ValueOrZero())
+}
@@ -0,0 +1,106 @@
+package zero
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestFloat32From(t *testing.T) {
+	assertFloat32(t, Float32From(0.1), "Float32From()")
+	assertNullFloat32(t, Float32From(0), "Float32From(0)")
+	assertNullFloat32(t, Float32FromPtr(nil), "Float32FromPtr(nil)")
+}
+
+func TestUnmarshalFloat32(t *testing.T) {
+	var f Float32
+	err := json.Unmarshal([]byte(`0.1`), &f)
+	maybePanic(err)
+	assertFloat32(t, f, "float32 json")
+
+	var zero Float32
+	err = json.Unmarshal([]byte(`0`), &zero)
+	maybePanic(err)
+	assertNullFloat32(t, zero, "zero json")
+
+	var null Float32
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullFloat32(t, null, "null json")
+
+	if err := json.Unmarshal([]byte(`1e39`), &f); err == nil {
+		t.Error("expected error: overflowing number")
+	}
+}
+
+func TestMarshalFloat32(t *testing.T) {
+	data, err := json.Marshal(Float32From(0.1))
+	maybePanic(err)
+	assertJSONEquals(t, data, "0.1", "non-empty json marshal")
+
+	data, err = json.Marshal(Float32{Float32: 0.1})
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+
+	data, err = Float32{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+
+	var text Float32
+	maybePanic(text.UnmarshalText([]byte("0")))
+	assertNullFloat32(t, text, "zero text")
+}
+
+func TestFloat32Scan(t *testing.T) {
+	var f Float32
+	err := f.Scan("0.1")
+	maybePanic(err)
+	assertFloat32(t, f, "scanned")
+
+	var overflow Float32
+	if err := overflow.Scan(math.MaxFloat64); err == nil {
+		t.Error("expected error: scanning float64 out of range")
+	}
+	assertNullFloat32(t, overflow, "scanned overflow")
+}
+
+func TestFloat32InfNaN(t *testing.T) {
+	if _, err := Float32From(float32(math.NaN())).MarshalJSON(); err == nil {
+		t.Error("expected error for NaN, got nil")
+	}
+	if _, err := Float32From(float32(math.Inf(1))).MarshalJSON(); err == nil {
+		t.Error("expected error for Inf, got nil")
+	}
+	if _, err := (Float32{Float32: float32(math.NaN())}).MarshalJSON(); err != nil {
+		t.Error("null NaN should marshal as 0:", err)
+	}
+}
+
+func TestFloat32Equal(t *testing.T) {
+	if !Float32From(0).Equal(Float32{}) {
+		t.Error("zero should equal null")
+	}
+	if Float32From(0.1).Equal(Float32{}) {
+		t.Error("0.1 should not equal null")
+	}
+	if got := Float32From(-1).Compare(Float32{}); got != -1 {
+		t.Error("-1 vs null:", got)
+	}
+}
+
+func assertFloat32(t *testing.T, f Float32, from string) {
+	t.Helper()
+	if f.Float32 != 0.1 {
+		t.Errorf("bad %s float32: %v ≠ %v\n", from, f.Float32, 0.1)
+	}
+	if !f.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullFloat32(t *testing.T, f Float32, from string) {
+	t.Helper()
+	if f.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
@@ -1,6 +1,7 @@
 package zero
 
 import (
+	"cmp"
 	"database/sql"
 	"strconv"
 
@@ -84,21 +85,25 @@ func (i *Int) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode 0 if this Int is null.
 func (i Int) MarshalJSON() ([]byte, error) {
-	n := i.Int64
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(n, 10)), nil
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Int to dst, as MarshalJSON does.
+// It will append 0 if this Int is null.
+func (i Int) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, i.ValueOrZero(), 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Int is null.
 func (i Int) MarshalText() ([]byte, error) {
-	n := i.Int64
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(n, 10)), nil
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Int is null.
+func (i Int) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, i.ValueOrZero(), 10), nil
 }
 
 // SetValid changes this Int's value and also sets it to be non-null.
@@ -125,6 +130,12 @@ func (i Int) Equal(other Int) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
 
+// Compare returns an integer comparing two Ints, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+func (i Int) Compare(other Int) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
+
 func (i Int) value() (int64, bool) {
 	return i.Int64, i.Valid
 }
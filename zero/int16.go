@@ -1,7 +1,9 @@
 package zero
 
 import (
+	"cmp"
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/guregu/null/v6/internal"
@@ -54,6 +56,16 @@ func (i Int16) ValueOr(v int16) int16 {
 	return i.Int16
 }
 
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range instead of truncating it.
+func (i *Int16) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Int16, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't scan Int16: %w", err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int16.
@@ -81,21 +93,25 @@ func (i *Int16) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode 0 if this Int16 is null.
 func (i Int16) MarshalJSON() ([]byte, error) {
-	n := i.Int16
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Int16 to dst, as MarshalJSON does.
+// It will append 0 if this Int16 is null.
+func (i Int16) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(i.ValueOrZero()), 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Int16 is null.
 func (i Int16) MarshalText() ([]byte, error) {
-	n := i.Int16
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Int16 is null.
+func (i Int16) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(i.ValueOrZero()), 10), nil
 }
 
 // SetValid changes this Int16's value and also sets it to be non-null.
@@ -122,6 +138,12 @@ func (i Int16) Equal(other Int16) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
 
+// Compare returns an integer comparing two Int16s, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+func (i Int16) Compare(other Int16) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
+
 func (i Int16) value() (int64, bool) {
 	return int64(i.Int16), i.Valid
 }
@@ -1,7 +1,9 @@
 package zero
 
 import (
+	"cmp"
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/guregu/null/v6/internal"
@@ -54,6 +56,16 @@ func (i Int32) ValueOr(v int32) int32 {
 	return i.Int32
 }
 
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range instead of truncating it.
+func (i *Int32) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Int32, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't scan Int32: %w", err)
+	}
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int32.
@@ -81,21 +93,25 @@ func (i *Int32) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode 0 if this Int32 is null.
 func (i Int32) MarshalJSON() ([]byte, error) {
-	n := i.Int32
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Int32 to dst, as MarshalJSON does.
+// It will append 0 if this Int32 is null.
+func (i Int32) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(i.ValueOrZero()), 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a zero if this Int32 is null.
 func (i Int32) MarshalText() ([]byte, error) {
-	n := i.Int32
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatInt(int64(n), 10)), nil
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Int32 is null.
+func (i Int32) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(i.ValueOrZero()), 10), nil
 }
 
 // SetValid changes this Int32's value and also sets it to be non-null.
@@ -122,6 +138,12 @@ func (i Int32) Equal(other Int32) bool {
 	return i.ValueOrZero() == other.ValueOrZero()
 }
 
+// Compare returns an integer comparing two Int32s, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+func (i Int32) Compare(other Int32) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
+
 func (i Int32) value() (int64, bool) {
 	return int64(i.Int32), i.Valid
 }
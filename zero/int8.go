@@ -0,0 +1,156 @@
+package zero
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Int8 is a nullable int8.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Int8 struct {
+	Int8  int8
+	Valid bool // Valid is true if Int8 is not NULL
+}
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{
+		Int8:  i,
+		Valid: valid,
+	}
+}
+
+// Int8From creates a new Int8 that will be null if zero.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, i != 0)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	n := NewInt8(*i, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int8) ValueOrZero() int8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int8
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (i Int8) ValueOr(v int8) int8 {
+	if !i.Valid {
+		return v
+	}
+	return i.Int8
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// returning an error if the value is out of range instead of truncating it.
+func (i *Int8) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Int8, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't scan Int8: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (i Int8) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Int8), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Int8.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	err := internal.UnmarshalIntJSON(data, &i.Int8, &i.Valid, 8, strconv.ParseInt)
+	if err != nil {
+		return err
+	}
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int8 if the input is a blank, or zero.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Int8) UnmarshalText(text []byte) error {
+	err := internal.UnmarshalIntText(text, &i.Int8, &i.Valid, 8, strconv.ParseInt)
+	if err != nil {
+		return err
+	}
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int8 is null.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Int8 to dst, as MarshalJSON does.
+// It will append 0 if this Int8 is null.
+func (i Int8) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(i.ValueOrZero()), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int8 is null.
+func (i Int8) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Int8 is null.
+func (i Int8) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendInt(dst, int64(i.ValueOrZero()), 10), nil
+}
+
+// SetValid changes this Int8's value and also sets it to be non-null.
+func (i *Int8) SetValid(n int8) {
+	i.Int8 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int8
+}
+
+// IsZero returns true for null or zero Int8s, for future omitempty support (Go 1.4?)
+func (i Int8) IsZero() bool {
+	return !i.Valid || i.Int8 == 0
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Int8) Equal(other Int8) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Compare returns an integer comparing two Int8s, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+func (i Int8) Compare(other Int8) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
+
+func (i Int8) value() (int64, bool) {
+	return int64(i.Int8), i.Valid
+}
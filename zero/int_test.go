@@ -18,7 +18,7 @@ var (
 )
 
 type nullint interface {
-	Int | Int32 | Int16 | Byte
+	Int | Int32 | Int16 | Int8 | Byte
 	IsZero() bool
 	value() (int64, bool)
 }
@@ -27,6 +27,7 @@ func TestIntFrom(t *testing.T) {
 	testIntFrom(t, IntFrom)
 	testIntFrom(t, Int32From)
 	testIntFrom(t, Int16From)
+	testIntFrom(t, Int8From)
 	testIntFrom(t, ByteFrom)
 }
 
@@ -47,6 +48,7 @@ func TestIntFromPtr(t *testing.T) {
 	testIntFromPtr(t, IntFromPtr)
 	testIntFromPtr(t, Int32FromPtr)
 	testIntFromPtr(t, Int16FromPtr)
+	testIntFromPtr(t, Int8FromPtr)
 	testIntFromPtr(t, ByteFromPtr)
 }
 
@@ -66,6 +68,7 @@ func TestUnmarshalInt(t *testing.T) {
 	testUnmarshalInt[Int](t)
 	testUnmarshalInt[Int32](t)
 	testUnmarshalInt[Int16](t)
+	testUnmarshalInt[Int8](t)
 	testUnmarshalInt[Byte](t)
 }
 
@@ -126,6 +129,7 @@ func TestUnmarshalIntOverflow(t *testing.T) {
 	testUnmarshalIntOverflow[Int, int64](t, math.MaxInt64)
 	testUnmarshalIntOverflow[Int32, int32](t, math.MaxInt32)
 	testUnmarshalIntOverflow[Int16, int16](t, math.MaxInt16)
+	testUnmarshalIntOverflow[Int8, int8](t, math.MaxInt8)
 	testUnmarshalIntOverflow[Byte, byte](t, math.MaxUint8)
 }
 
@@ -151,6 +155,7 @@ func TestTextUnmarshalInt(t *testing.T) {
 	testTextUnmarshalInt(t, (*Int).UnmarshalText)
 	testTextUnmarshalInt(t, (*Int32).UnmarshalText)
 	testTextUnmarshalInt(t, (*Int16).UnmarshalText)
+	testTextUnmarshalInt(t, (*Int8).UnmarshalText)
 	testTextUnmarshalInt(t, (*Byte).UnmarshalText)
 }
 
@@ -188,6 +193,7 @@ func TestMarshalInt(t *testing.T) {
 	testMarshalInt(t, NewInt)
 	testMarshalInt(t, NewInt32)
 	testMarshalInt(t, NewInt16)
+	testMarshalInt(t, NewInt8)
 	testMarshalInt(t, NewByte)
 }
 
@@ -210,6 +216,7 @@ func TestMarshalIntText(t *testing.T) {
 	testMarshalIntText(t, NewInt)
 	testMarshalIntText(t, NewInt32)
 	testMarshalIntText(t, NewInt16)
+	testMarshalIntText(t, NewInt8)
 	testMarshalIntText(t, NewByte)
 }
 
@@ -232,6 +239,7 @@ func TestIntPointer(t *testing.T) {
 	testIntPointer(t, NewInt)
 	testIntPointer(t, NewInt32)
 	testIntPointer(t, NewInt16)
+	testIntPointer(t, NewInt8)
 	testIntPointer(t, NewByte)
 }
 
@@ -255,6 +263,7 @@ func TestIntIsZero(t *testing.T) {
 	testIntIsZero(t, NewInt)
 	testIntIsZero(t, NewInt32)
 	testIntIsZero(t, NewInt16)
+	testIntIsZero(t, NewInt8)
 	testIntIsZero(t, NewByte)
 }
 
@@ -281,6 +290,7 @@ func TestIntScan(t *testing.T) {
 	testIntScan(t, (*Int).Scan)
 	testIntScan(t, (*Int32).Scan)
 	testIntScan(t, (*Int16).Scan)
+	testIntScan(t, (*Int8).Scan)
 	testIntScan(t, (*Byte).Scan)
 }
 
@@ -307,6 +317,7 @@ func TestIntSetValid(t *testing.T) {
 	testIntSetValid(t, NewInt, (*Int).SetValid)
 	testIntSetValid(t, NewInt32, (*Int32).SetValid)
 	testIntSetValid(t, NewInt16, (*Int16).SetValid)
+	testIntSetValid(t, NewInt8, (*Int8).SetValid)
 	testIntSetValid(t, NewByte, (*Byte).SetValid)
 }
 
@@ -323,6 +334,7 @@ func TestIntValueOrZero(t *testing.T) {
 	testIntValueOrZero(t, NewInt)
 	testIntValueOrZero(t, NewInt32)
 	testIntValueOrZero(t, NewInt16)
+	testIntValueOrZero(t, NewInt8)
 	testIntValueOrZero(t, NewByte)
 }
 
@@ -344,6 +356,7 @@ func TestIntValueOr(t *testing.T) {
 	testIntValueOr(t, NewInt)
 	testIntValueOr(t, NewInt32)
 	testIntValueOr(t, NewInt16)
+	testIntValueOr(t, NewInt8)
 	testIntValueOr(t, NewByte)
 }
 
@@ -365,6 +378,7 @@ func TestIntEqual(t *testing.T) {
 	testIntEqual(t, NewInt)
 	testIntEqual(t, NewInt32)
 	testIntEqual(t, NewInt16)
+	testIntEqual(t, NewInt8)
 	testIntEqual(t, NewByte)
 }
 
@@ -432,3 +446,29 @@ func assertIntEqualIsFalse[N interface{ Equal(N) bool }](t *testing.T, a, b N) {
 		t.Errorf("Equal() of %#v and %#v should return false", a, b)
 	}
 }
+
+func TestIntCompare(t *testing.T) {
+	testIntCompare(t, NewInt)
+	testIntCompare(t, NewInt32)
+	testIntCompare(t, NewInt16)
+	testIntCompare(t, NewInt8)
+	testIntCompare(t, NewByte)
+}
+
+func testIntCompare[N interface{ Compare(N) int }, V internal.Integer](t *testing.T, newInt func(V, bool) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		if c := newInt(10, true).Compare(newInt(20, true)); c != -1 {
+			t.Errorf("10 vs 20: %d", c)
+		}
+		if c := newInt(20, true).Compare(newInt(10, true)); c != 1 {
+			t.Errorf("20 vs 10: %d", c)
+		}
+		// null is equal to zero
+		if c := newInt(10, false).Compare(newInt(0, true)); c != 0 {
+			t.Errorf("null vs 0: %d", c)
+		}
+		if c := newInt(10, false).Compare(newInt(1, true)); c != -1 {
+			t.Errorf("null vs 1: %d", c)
+		}
+	})
+}
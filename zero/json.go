@@ -0,0 +1,194 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// JSON is a nullable raw JSON document, such as a JSON or JSONB column.
+// JSON marshals to null if null.
+// SQL NULL, an empty document, and the JSON literal null are all considered zero,
+// and a zero JSON is considered null to SQL.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool // Valid is true if JSON is not NULL
+}
+
+// NewJSON creates a new JSON.
+func NewJSON(b []byte, valid bool) JSON {
+	return JSON{
+		JSON:  b,
+		Valid: valid,
+	}
+}
+
+// JSONFrom creates a new JSON that will be null if b is empty or the literal null.
+// It does not copy b or check that it is valid JSON.
+func JSONFrom(b []byte) JSON {
+	return NewJSON(b, !isNullJSON(b))
+}
+
+// JSONFromPtr creates a new JSON that will be null if b is nil, empty, or the literal null.
+func JSONFromPtr(b *json.RawMessage) JSON {
+	if b == nil {
+		return NewJSON(nil, false)
+	}
+	return JSONFrom(*b)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (j JSON) ValueOrZero() json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (j JSON) ValueOr(v json.RawMessage) json.RawMessage {
+	if !j.Valid {
+		return v
+	}
+	return j.JSON
+}
+
+// Decode unmarshals this JSON's document into v, using encoding/json.
+// A zero JSON is decoded as the literal null, which sets pointers, maps, and slices to nil
+// and leaves other values unchanged.
+func (j JSON) Decode(v any) error {
+	if err := json.Unmarshal(j.document(), v); err != nil {
+		return fmt.Errorf("zero: couldn't decode JSON: %w", err)
+	}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts []byte and string values, and copies the data.
+// It does not check that the data is valid JSON.
+// The literal null produces a null JSON.
+func (j *JSON) Scan(src any) error {
+	switch x := src.(type) {
+	case nil:
+		j.JSON, j.Valid = nil, false
+		return nil
+	case []byte:
+		*j = JSONFrom(bytes.Clone(x))
+		return nil
+	case string:
+		*j = JSONFrom(json.RawMessage(x))
+		return nil
+	}
+	j.JSON, j.Valid = nil, false
+	return fmt.Errorf("zero: couldn't scan JSON: unsupported type %T", src)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the document as []byte, or nil if this JSON is zero.
+// Unlike other types in this package, a valid but empty JSON is sent as NULL,
+// because an empty document is not valid JSON.
+func (j JSON) Value() (driver.Value, error) {
+	if j.IsZero() {
+		return nil, nil
+	}
+	return []byte(j.JSON), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the document unchanged, or null if this JSON is zero.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return j.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this JSON to dst, as MarshalJSON does.
+// It will append null if this JSON is zero.
+func (j JSON) AppendJSON(dst []byte) ([]byte, error) {
+	return append(dst, j.document()...), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It copies the input document, and will unmarshal the literal null to a null JSON.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("zero: couldn't unmarshal JSON: no data")
+	}
+	*j = JSONFrom(bytes.Clone(data))
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the document unchanged, or null if this JSON is zero.
+func (j JSON) MarshalText() ([]byte, error) {
+	return j.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append null if this JSON is zero.
+func (j JSON) AppendText(dst []byte) ([]byte, error) {
+	return append(dst, j.document()...), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null JSON if the input is blank or "null".
+// It returns an error if the input is not valid JSON.
+func (j *JSON) UnmarshalText(text []byte) error {
+	if isNullJSON(text) {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	if !json.Valid(text) {
+		return errors.New("zero: couldn't unmarshal text: invalid JSON")
+	}
+	j.JSON, j.Valid = bytes.Clone(text), true
+	return nil
+}
+
+// SetValid changes this JSON's value and also sets it to be non-null.
+func (j *JSON) SetValid(v json.RawMessage) {
+	j.JSON = v
+	j.Valid = true
+}
+
+// Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return &j.JSON
+}
+
+// IsZero returns true for null, empty, or literal null JSONs.
+func (j JSON) IsZero() bool {
+	return !j.Valid || isNullJSON(j.JSON)
+}
+
+// Equal returns true if both JSONs hold byte-for-byte identical documents or are both zero.
+func (j JSON) Equal(other JSON) bool {
+	if j.IsZero() || other.IsZero() {
+		return j.IsZero() == other.IsZero()
+	}
+	return bytes.Equal(j.JSON, other.JSON)
+}
+
+// Compare returns an integer comparing two JSONs by their raw bytes, following the conventions of cmp.Compare.
+// Null and zero JSONs are less than any other value. This gives a stable order for sorting,
+// but documents that are equivalent yet formatted differently are not considered equal.
+func (j JSON) Compare(other JSON) int {
+	return internal.CompareFunc([]byte(j.JSON), !j.IsZero(), []byte(other.JSON), !other.IsZero(), bytes.Compare)
+}
+
+// document returns the document, or the literal null if this JSON is zero.
+func (j JSON) document() []byte {
+	if j.IsZero() {
+		return []byte("null")
+	}
+	return j.JSON
+}
+
+func isNullJSON(b []byte) bool {
+	return len(b) == 0 || string(b) == "null"
+}
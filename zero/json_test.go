@@ -0,0 +1,72 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	doc := []byte(`{"a":1}`)
+	for _, b := range [][]byte{nil, {}, []byte("null")} {
+		if JSONFrom(b).Valid {
+			t.Errorf("JSONFrom(%q) should be null", b)
+		}
+		var scanned JSON
+		err := scanned.Scan(b)
+		maybePanic(err)
+		if !scanned.IsZero() {
+			t.Errorf("scanning %q should be zero", b)
+		}
+	}
+
+	src := bytes.Clone(doc)
+	var j JSON
+	err := j.Scan(src)
+	maybePanic(err)
+	src[0] = '['
+	if !j.Valid || !bytes.Equal(j.JSON, doc) {
+		t.Errorf("bad scan: %#v", j)
+	}
+
+	v, err := NewJSON(nil, true).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("empty Value() should be nil, got %#v", v)
+	}
+	v, err = j.Value()
+	maybePanic(err)
+	if b, ok := v.([]byte); !ok || !bytes.Equal(b, doc) {
+		t.Errorf("bad Value(): %#v", v)
+	}
+
+	data, err := json.Marshal(JSON{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+	data, err = json.Marshal(j)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(doc), "json marshal")
+
+	var m map[string]int
+	err = j.Decode(&m)
+	maybePanic(err)
+	if m["a"] != 1 {
+		t.Errorf("bad Decode(): %v", m)
+	}
+
+	if !(JSON{}).Equal(JSONFrom([]byte("null"))) {
+		t.Error("null should equal the literal null")
+	}
+}
+
+func TestJSONCompare(t *testing.T) {
+	if c := JSONFrom([]byte(`[1]`)).Compare(JSONFrom([]byte(`[2]`))); c != -1 {
+		t.Errorf("[1] vs [2]: %d", c)
+	}
+	if c := (JSON{}).Compare(NewJSON([]byte(`null`), true)); c != 0 {
+		t.Errorf("null vs literal null: %d", c)
+	}
+	if c := JSONFrom([]byte(`0`)).Compare(JSON{}); c != 1 {
+		t.Errorf("0 vs null: %d", c)
+	}
+}
@@ -0,0 +1,682 @@
+// go1.27: encoding/json/v2 and encoding/json/jsontext (json.MarshalerTo, jsontext.Encoder, ...)
+// first appear in the Go API in api/go1.27.txt, and vet rejects them in files built for older versions.
+//go:build goexperiment.jsonv2 && go1.27
+
+package zero
+
+import (
+	"bytes"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// With GOEXPERIMENT=jsonv2, the types in this package also implement
+// json.MarshalerTo and json.UnmarshalerFrom from encoding/json/v2.
+// They stream values through jsontext without allocating where possible,
+// and respect json/v2 options: numbers are written as strings for StringifyNumbers
+// or a `string` tag, and times and durations are handed to json/v2 itself,
+// so they follow its options and format tags.
+// When called with encoding/json v1 semantics, they defer to MarshalJSON and UnmarshalJSON.
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode a blank string if this String is null.
+func (s String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return enc.WriteToken(jsontext.String(s.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input. Blank strings will be considered null.
+func (s *String) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		s.Valid = false
+		return nil
+	}
+	s.String = string(text)
+	s.Valid = s.String != ""
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalIntTo(enc, i.ValueOrZero())
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Int.
+func (i *Int) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int64, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Int32 is null.
+func (i Int32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalIntTo(enc, int64(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Int32.
+func (i *Int32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int32, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Int32 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Int16 is null.
+func (i Int16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalIntTo(enc, int64(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Int16.
+func (i *Int16) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int16, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Int16 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Int8 is null.
+func (i Int8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalIntTo(enc, int64(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Int8.
+func (i *Int8) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Int8, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Byte is null.
+func (b Byte) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalUintTo(enc, uint64(b.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Byte.
+func (b *Byte) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &b.Byte, &b.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	b.Valid = b.Byte != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Uint is null.
+func (i Uint) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalUintTo(enc, i.ValueOrZero())
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Uint.
+func (i *Uint) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint64, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Uint64 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Uint32 is null.
+func (i Uint32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalUintTo(enc, uint64(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Uint32.
+func (i *Uint32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint32, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Uint32 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Uint16 is null.
+func (i Uint16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalUintTo(enc, uint64(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Uint16.
+func (i *Uint16) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint16, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Uint16 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Uint8 is null.
+func (i Uint8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalUintTo(enc, uint64(i.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Uint8.
+func (i *Uint8) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalIntFrom(dec, &i.Uint8, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	i.Valid = i.Uint8 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Float is null.
+func (f Float) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalFloatTo(enc, f.ValueOrZero(), 64)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Float.
+func (f *Float) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalFloatFrom(dec, &f.Float64, &f.Valid, 64); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this Float32 is null.
+func (f Float32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalFloatTo(enc, float64(f.ValueOrZero()), 32)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null Float32.
+func (f *Float32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	if err := internal.UnmarshalFloatFrom(dec, &f.Float32, &f.Valid, 32); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	f.Valid = f.Float32 != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode 0 if this BigInt is null.
+func (b BigInt) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return b.marshalJSONTo(enc, false)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode "0" if this BigIntString is null.
+func (b BigIntString) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return b.marshalJSONTo(enc, true)
+}
+
+func (b BigInt) marshalJSONTo(enc *jsontext.Encoder, quote bool) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var buf [64]byte
+	return internal.MarshalNumberTo(enc, internal.AppendBigInt(buf[:0], b.value()), quote)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports number, number string, and null input.
+// 0 will be considered a null BigInt.
+func (b *BigInt) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadNumberFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		b.Int, b.Valid = nil, false
+		return nil
+	}
+	i, err := internal.ParseBigInt(string(text))
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	b.Int, b.Valid = i, i.Sign() != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode false if this Bool is null.
+func (b Bool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return enc.WriteToken(jsontext.Bool(b.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports true, false, and null input.
+// False will be considered a null Bool.
+func (b *Bool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	switch tok.Kind() {
+	case 'n':
+		b.Valid = false
+		return nil
+	case 't', 'f':
+		b.Bool = tok.Bool()
+		b.Valid = b.Bool
+		return nil
+	}
+	return fmt.Errorf("zero: couldn't unmarshal JSON: unexpected JSON %s, want boolean", tok.Kind())
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode the zero value of time.Time if this Time is null.
+// The time is encoded as json/v2 would, following its options and format tags.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return jsonv2.MarshalEncode(enc, t.ValueOrZero())
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports null and blank string input, and otherwise decodes the time as json/v2 would,
+// following its options and format tags. The zero time will be considered null.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	val, err := dec.ReadValue()
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	switch string(val) {
+	case "null", `""`:
+		t.Valid = false
+		return nil
+	}
+	if err := jsonv2.Unmarshal(val, &t.Time, dec.Options()); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode "0001-01-01" if this Date is null.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var buf [len(internal.DateLayout)]byte
+	return internal.MarshalQuotedTo(enc, d.appendText(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input. Blank strings and "0001-01-01" will be considered null.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok || len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+	year, month, day, err := internal.ParseDate(string(text))
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	*d = DateFrom(year, month, day)
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode a zero duration if this Duration is null.
+// It encodes the duration as json/v2 would, following options such as FormatDurationAsNano,
+// or as a string such as "1m30s" if they don't select a representation.
+func (d Duration) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return internal.MarshalDurationTo(enc, d.ValueOrZero())
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports the representation selected by json/v2 options, as well as strings such as "1m30s",
+// numbers of nanoseconds, blank strings, and null input.
+// A zero duration will be considered null.
+func (d *Duration) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	val, err := dec.ReadValue()
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	switch string(val) {
+	case "null", `""`:
+		d.Duration, d.Valid = 0, false
+		return nil
+	}
+	v, err := internal.UnmarshalDurationFrom(val, dec.Options())
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	d.Duration, d.Valid = v, v != 0
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode the document unchanged, or null if this JSON is zero.
+func (j JSON) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	return enc.WriteValue(j.document())
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It keeps a copy of any JSON value. The literal null unmarshals to a null JSON.
+func (j *JSON) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	val, err := dec.ReadValue()
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	*j = JSONFrom(bytes.Clone(val))
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode a blank string if this Bytes is null, otherwise a base64 string.
+func (b Bytes) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var buf [64]byte
+	text, _ := internal.AppendBytes(buf[:0], b.ValueOrZero(), internal.BytesBase64)
+	return internal.MarshalQuotedTo(enc, text)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports base64 string and null input. Empty input will be considered null.
+func (b *Bytes) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		*b = Bytes{}
+		return nil
+	}
+	v, err := internal.DecodeBytes(text, internal.BytesBase64)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	*b = BytesFrom(v)
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode the nil UUID if this UUID is null.
+func (u UUID) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var buf [internal.UUIDLen]byte
+	return internal.MarshalQuotedTo(enc, internal.AppendUUID(buf[:0], u.ValueOrZero()))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input. Blank strings and the nil UUID will be considered null.
+func (u *UUID) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok || len(text) == 0 {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	v, err := internal.ParseUUID(string(text))
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	*u = UUIDFrom(v)
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode a blank string if this Addr is null.
+func (a Addr) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var buf [64]byte
+	return internal.MarshalQuotedTo(enc, a.ValueOrZero().AppendTo(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input. Blank strings will be considered null.
+func (a *Addr) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		a.Addr, a.Valid = netip.Addr{}, false
+		return nil
+	}
+	return a.UnmarshalText(text)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode a blank string if this Prefix is null.
+func (p Prefix) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var buf [64]byte
+	return internal.MarshalQuotedTo(enc, p.ValueOrZero().AppendTo(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input. Blank strings will be considered null.
+func (p *Prefix) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		p.Prefix, p.Valid = netip.Prefix{}, false
+		return nil
+	}
+	return p.UnmarshalText(text)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode a blank string if this AddrPort is null.
+func (ap AddrPort) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var buf [64]byte
+	return internal.MarshalQuotedTo(enc, ap.ValueOrZero().AppendTo(buf[:0]))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports string and null input. Blank strings will be considered null.
+func (ap *AddrPort) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	text, ok, err := internal.ReadStringFrom(dec)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if !ok {
+		ap.AddrPort, ap.Valid = netip.AddrPort{}, false
+		return nil
+	}
+	return ap.UnmarshalText(text)
+}
+
+// MarshalJSONTo implements json.MarshalerTo.
+// It will encode null if this Value is null or zero, otherwise T as json/v2 would.
+func (t Value[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if internal.LegacyJSON(enc.Options()) {
+		return errors.ErrUnsupported
+	}
+	var zero T
+	if !t.Valid || t.V == zero {
+		return internal.MarshalNullTo(enc)
+	}
+	return jsonv2.MarshalEncode(enc, t.V)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom.
+// It supports null input, and otherwise decodes T as json/v2 would.
+// The zero value of T will be considered null.
+func (t *Value[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if internal.LegacyJSON(dec.Options()) {
+		return errors.ErrUnsupported
+	}
+	var zero T
+	if null, err := internal.ReadNullFrom(dec); null || err != nil {
+		t.V, t.Valid = zero, false
+		return err
+	}
+	if err := jsonv2.UnmarshalDecode(dec, &t.V); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	t.Valid = t.V != zero
+	return nil
+}
@@ -0,0 +1,115 @@
+// go1.27: encoding/json/v2 and encoding/json/jsontext (json.MarshalerTo, jsontext.Encoder, ...)
+// first appear in the Go API in api/go1.27.txt, and vet rejects them in files built for older versions.
+//go:build goexperiment.jsonv2 && go1.27
+
+package zero
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// jsonTypes is every type in this package, which should all support json/v2.
+var jsonTypes = []any{
+	String{}, Int{}, Int32{}, Int16{}, Int8{}, Byte{}, Uint{}, Uint32{}, Uint16{}, Uint8{},
+	Float{}, Float32{}, BigInt{}, Bool{}, Time{}, Date{}, Duration{},
+	JSON{}, Bytes{}, UUID{}, Addr{}, Prefix{}, AddrPort{}, Value[int]{},
+}
+
+func TestJSONv2Interfaces(t *testing.T) {
+	for _, v := range jsonTypes {
+		if _, ok := v.(jsonv2.MarshalerTo); !ok {
+			t.Errorf("%T doesn't implement json.MarshalerTo", v)
+		}
+		if _, ok := reflect.New(reflect.TypeOf(v)).Interface().(jsonv2.UnmarshalerFrom); !ok {
+			t.Errorf("%T doesn't implement json.UnmarshalerFrom", v)
+		}
+	}
+}
+
+type jsonv2Record struct {
+	ID     Int        `json:"id"`
+	Name   String     `json:"name"`
+	Score  Float32    `json:"score"`
+	Big    BigInt     `json:"big"`
+	Active Bool       `json:"active"`
+	Born   Date       `json:"born"`
+	Seen   Time       `json:"seen"`
+	Wait   Duration   `json:"wait"`
+	Raw    Bytes      `json:"raw"`
+	UUID   UUID       `json:"uuid"`
+	IP     Addr       `json:"ip"`
+	Count  Value[int] `json:"count"`
+}
+
+func TestJSONv2Zero(t *testing.T) {
+	data, err := jsonv2.Marshal(jsonv2Record{})
+	maybePanic(err)
+	want := `{"id":0,"name":"","score":0,"big":0,"active":false,"born":"0001-01-01",` +
+		`"seen":"0001-01-01T00:00:00Z","wait":"0s","raw":"","uuid":"00000000-0000-0000-0000-000000000000",` +
+		`"ip":"","count":null}`
+	assertJSONEquals(t, data, want, "json/v2 null marshal")
+
+	out := jsonv2Record{ID: IntFrom(1), Name: StringFrom("x"), Seen: TimeFrom(time.Now()), Count: ValueFrom(1)}
+	err = jsonv2.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.ID.Valid || out.Name.Valid || out.Score.Valid || out.Big.Valid || out.Active.Valid || out.Born.Valid ||
+		out.Seen.Valid || out.Wait.Valid || out.Raw.Valid || out.UUID.Valid || out.IP.Valid || out.Count.Valid {
+		t.Errorf("zero values should unmarshal as null: %+v", out)
+	}
+
+	err = jsonv2.Unmarshal([]byte(`{"id":null,"name":null,"seen":"","wait":"","uuid":""}`), &out)
+	maybePanic(err)
+	if out.ID.Valid || out.Name.Valid || out.Seen.Valid || out.Wait.Valid || out.UUID.Valid {
+		t.Errorf("null and blank input should unmarshal as null: %+v", out)
+	}
+
+	data, err = jsonv2.Marshal(BigIntString{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0"`, "null BigIntString marshal")
+}
+
+func TestJSONv2RoundTrip(t *testing.T) {
+	in := jsonv2Record{
+		ID:     IntFrom(1),
+		Name:   StringFrom("<test>"),
+		Score:  Float32From(0.1),
+		Active: BoolFrom(true),
+		Born:   DateFrom(2000, time.January, 2),
+		Seen:   TimeFrom(time.Date(2012, time.December, 21, 21, 21, 21, 0, time.UTC)),
+		Wait:   DurationFrom(90 * time.Second),
+		Count:  ValueFrom(3),
+	}
+	data, err := jsonv2.Marshal(in, jsonv2.StringifyNumbers(true))
+	maybePanic(err)
+	want := `{"id":"1","name":"<test>","score":"0.1","big":"0","active":true,"born":"2000-01-02",` +
+		`"seen":"2012-12-21T21:21:21Z","wait":"1m30s","raw":"","uuid":"00000000-0000-0000-0000-000000000000",` +
+		`"ip":"","count":"3"}`
+	assertJSONEquals(t, data, want, "json/v2 marshal")
+
+	var out jsonv2Record
+	err = jsonv2.Unmarshal(data, &out, jsonv2.StringifyNumbers(true))
+	maybePanic(err)
+	if !out.ID.Equal(in.ID) || !out.Name.Equal(in.Name) || !out.Score.Equal(in.Score) ||
+		!out.Active.Equal(in.Active) || !out.Born.Equal(in.Born) || !out.Seen.Equal(in.Seen) ||
+		!out.Wait.Equal(in.Wait) || !out.Count.Equal(in.Count) {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+
+	if err := jsonv2.Unmarshal([]byte(`{"id":"x"}`), &out); err == nil {
+		t.Error("expected error: bad number string")
+	}
+}
+
+func TestJSONv2Allocs(t *testing.T) {
+	enc := jsontext.NewEncoder(io.Discard)
+	for _, v := range []jsonv2.MarshalerTo{Int{}, IntFrom(123456), FloatFrom(1.5), StringFrom("hello"), Bool{}} {
+		if n := testing.AllocsPerRun(100, func() { maybePanic(v.MarshalJSONTo(enc)) }); n != 0 {
+			t.Errorf("%T: %v allocations, want 0", v, n)
+		}
+	}
+}
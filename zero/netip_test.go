@@ -0,0 +1,75 @@
+package zero
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+)
+
+func TestNetip(t *testing.T) {
+	if AddrFrom(netip.Addr{}).Valid || PrefixFrom(netip.Prefix{}).Valid || AddrPortFrom(netip.AddrPort{}).Valid {
+		t.Error("zero netip values should be null")
+	}
+	if !AddrFrom(netip.IPv4Unspecified()).Valid {
+		t.Error("0.0.0.0 should be valid")
+	}
+
+	for _, v := range []interface {
+		MarshalJSON() ([]byte, error)
+		MarshalText() ([]byte, error)
+	}{Addr{}, Prefix{}, AddrPort{}, NewAddr(netip.Addr{}, true)} {
+		data, err := v.MarshalJSON()
+		maybePanic(err)
+		assertJSONEquals(t, data, `""`, "null json marshal")
+		data, err = v.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, "", "null text marshal")
+	}
+
+	for _, data := range []string{`null`, `""`} {
+		var a Addr
+		err := json.Unmarshal([]byte(data), &a)
+		maybePanic(err)
+		if !a.IsZero() {
+			t.Errorf("%s should unmarshal to null", data)
+		}
+	}
+
+	var a Addr
+	err := a.Scan("192.0.2.1/32")
+	maybePanic(err)
+	if !a.Equal(AddrFrom(netip.MustParseAddr("192.0.2.1"))) {
+		t.Errorf("bad scan: %#v", a)
+	}
+	var p Prefix
+	err = p.Scan("192.0.2.1")
+	maybePanic(err)
+	if p.Prefix.Bits() != 32 {
+		t.Errorf("bad host prefix: %v", p.Prefix)
+	}
+	var text Addr
+	err = json.Unmarshal([]byte(`"192.0.2.1/32"`), &text)
+	maybePanic(err)
+	if !text.Equal(a) {
+		t.Errorf("bad unmarshal of INET text: %#v", text)
+	}
+	var textPrefix Prefix
+	err = textPrefix.UnmarshalText([]byte("192.0.2.1"))
+	maybePanic(err)
+	if !textPrefix.Equal(p) {
+		t.Errorf("bad unmarshal of a bare address: %v", textPrefix.Prefix)
+	}
+	var ap AddrPort
+	err = ap.Scan([]byte("192.0.2.1:80"))
+	maybePanic(err)
+	if ap.AddrPort.Port() != 80 {
+		t.Errorf("bad scan: %v", ap.AddrPort)
+	}
+
+	if !(Addr{}).Equal(NewAddr(netip.Addr{}, true)) {
+		t.Error("null should equal zero")
+	}
+	if c := (Prefix{}).Compare(p); c != -1 {
+		t.Errorf("null vs prefix: %d", c)
+	}
+}
@@ -5,9 +5,12 @@
 package zero
 
 import (
+	"cmp"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 // String is a nullable string.
@@ -65,7 +68,9 @@ func (s *String) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &s.String); err != nil {
+	if str, ok := internal.UnquoteSimpleJSON(data); ok {
+		s.String = string(str)
+	} else if err := json.Unmarshal(data, &s.String); err != nil {
 		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
 	}
 
@@ -76,10 +81,19 @@ func (s *String) UnmarshalJSON(data []byte) error {
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string when this String is null.
 func (s String) MarshalText() ([]byte, error) {
-	if !s.Valid {
-		return []byte{}, nil
-	}
-	return []byte(s.String), nil
+	return s.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append nothing if this String is null.
+func (s String) AppendText(dst []byte) ([]byte, error) {
+	return append(dst, s.ValueOrZero()...), nil
+}
+
+// AppendJSON appends the JSON encoding of this String to dst, as encoding/json would.
+// It will append a blank string if this String is null.
+func (s String) AppendJSON(dst []byte) ([]byte, error) {
+	return internal.AppendJSONString(dst, s.ValueOrZero()), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -113,3 +127,9 @@ func (s String) IsZero() bool {
 func (s String) Equal(other String) bool {
 	return s.ValueOrZero() == other.ValueOrZero()
 }
+
+// Compare returns an integer comparing two Strings, following the conventions of cmp.Compare.
+// Null is considered equal to the empty string.
+func (s String) Compare(other String) int {
+	return cmp.Compare(s.ValueOrZero(), other.ValueOrZero())
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 // Time is a nullable time.Time.
@@ -68,10 +70,18 @@ func (t Time) ValueOr(v time.Time) time.Time {
 // It will encode the zero value of time.Time
 // if this time is invalid.
 func (t Time) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
-		return (time.Time{}).MarshalJSON()
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Time to dst, as MarshalJSON does.
+// It will append the zero value of time.Time if this Time is null.
+func (t Time) AppendJSON(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst, err := internal.AppendTime(append(dst, '"'), t.ValueOrZero(), "Time.MarshalJSON: ")
+	if err != nil {
+		return dst[:n], err
 	}
-	return t.Time.MarshalJSON()
+	return append(dst, '"'), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -83,8 +93,12 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &t.Time); err != nil {
-		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	// time.Time's UnmarshalJSON handles strings without escapes on its own,
+	// so only go through encoding/json for anything else or to report an error
+	if _, ok := internal.UnquoteSimpleJSON(data); !ok || t.Time.UnmarshalJSON(data) != nil {
+		if err := json.Unmarshal(data, &t.Time); err != nil {
+			return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+		}
 	}
 
 	t.Valid = !t.Time.IsZero()
@@ -94,11 +108,13 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 // MarshalText implements encoding.TextMarshaler.
 // It will encode to an empty time.Time if invalid.
 func (t Time) MarshalText() ([]byte, error) {
-	ti := t.Time
-	if !t.Valid {
-		ti = time.Time{}
-	}
-	return ti.MarshalText()
+	return t.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append the zero value of time.Time if this Time is null.
+func (t Time) AppendText(dst []byte) ([]byte, error) {
+	return internal.AppendTime(dst, t.ValueOrZero(), "Time.MarshalText: ")
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -146,6 +162,13 @@ func (t Time) Equal(other Time) bool {
 	return t.ValueOrZero().Equal(other.ValueOrZero())
 }
 
+// Compare returns an integer comparing two Times, following the conventions of cmp.Compare.
+// Times are compared with time.Time's Compare method, so locations are ignored.
+// Null is considered equal to the zero time.
+func (t Time) Compare(other Time) int {
+	return t.ValueOrZero().Compare(other.ValueOrZero())
+}
+
 // ExactEqual returns true if both Time objects are equal or both are either null or zero.
 // ExactEqual returns false for times that are in different locations or
 // have a different monotonic clock reading.
@@ -0,0 +1,165 @@
+package zero
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// Uint is a nullable uint64.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint struct {
+	Uint64 uint64
+	Valid  bool // Valid is true if Uint64 is not NULL
+}
+
+// Uint64 is an alias for Uint.
+type Uint64 = Uint
+
+// NewUint creates a new Uint
+func NewUint(i uint64, valid bool) Uint {
+	return Uint{
+		Uint64: i,
+		Valid:  valid,
+	}
+}
+
+// UintFrom creates a new Uint that will be null if zero.
+func UintFrom(i uint64) Uint {
+	return NewUint(i, i != 0)
+}
+
+// UintFromPtr creates a new Uint that be null if i is nil.
+func UintFromPtr(i *uint64) Uint {
+	if i == nil {
+		return NewUint(0, false)
+	}
+	n := NewUint(*i, true)
+	return n
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint) ValueOrZero() uint64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Uint64
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (i Uint) ValueOr(v uint64) uint64 {
+	if !i.Valid {
+		return v
+	}
+	return i.Uint64
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts integer values as well as decimal strings,
+// so the full range of uint64 can be scanned.
+func (i *Uint) Scan(src any) error {
+	if err := internal.ScanInt(src, &i.Uint64, &i.Valid); err != nil {
+		return fmt.Errorf("zero: couldn't scan Uint: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns an error if this Uint's value is too large for int64,
+// the largest integer type supported by database/sql/driver.
+func (i Uint) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	if i.Uint64 > math.MaxInt64 {
+		return nil, fmt.Errorf("zero: Uint value %d overflows int64", i.Uint64)
+	}
+	return int64(i.Uint64), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+// 0 will be considered a null Uint.
+func (i *Uint) UnmarshalJSON(data []byte) error {
+	err := internal.UnmarshalIntJSON(data, &i.Uint64, &i.Valid, 64, strconv.ParseUint)
+	if err != nil {
+		return err
+	}
+	i.Valid = i.Uint64 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint if the input is a blank, or zero.
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *Uint) UnmarshalText(text []byte) error {
+	err := internal.UnmarshalIntText(text, &i.Uint64, &i.Valid, 64, strconv.ParseUint)
+	if err != nil {
+		return err
+	}
+	i.Valid = i.Uint64 != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint is null.
+func (i Uint) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Uint to dst, as MarshalJSON does.
+// It will append 0 if this Uint is null.
+func (i Uint) AppendJSON(dst []byte) ([]byte, error) {
+	return strconv.AppendUint(dst, i.ValueOrZero(), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint is null.
+func (i Uint) MarshalText() ([]byte, error) {
+	return i.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append a zero if this Uint is null.
+func (i Uint) AppendText(dst []byte) ([]byte, error) {
+	return strconv.AppendUint(dst, i.ValueOrZero(), 10), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (i *Uint) SetValid(n uint64) {
+	i.Uint64 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
+func (i Uint) Ptr() *uint64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Uint64
+}
+
+// IsZero returns true for null or zero Uints, for future omitempty support (Go 1.4?)
+func (i Uint) IsZero() bool {
+	return !i.Valid || i.Uint64 == 0
+}
+
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Uint) Equal(other Uint) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Compare returns an integer comparing two Uints, following the conventions of cmp.Compare.
+// Null is considered equal to zero.
+func (i Uint) Compare(other Uint) int {
+	return cmp.Compare(i.ValueOrZero(), other.ValueOrZero())
+}
+
+func (i Uint) value() (uint64, bool) {
+	return i.Uint64, i.Valid
+}
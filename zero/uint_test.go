@@ -0,0 +1,314 @@
+package zero
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+type nulluint interface {
+	Uint | Uint32 | Uint16 | Uint8
+	IsZero() bool
+	value() (uint64, bool)
+}
+
+func TestUintFrom(t *testing.T) {
+	testUintFrom(t, UintFrom)
+	testUintFrom(t, Uint32From)
+	testUintFrom(t, Uint16From)
+	testUintFrom(t, Uint8From)
+}
+
+func testUintFrom[N nulluint, V internal.Unsigned](t *testing.T, from func(V) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		i := from(123)
+		assertUint(t, i, "from(123)")
+
+		zero := from(0)
+		assertNullUint(t, zero, "from(0)")
+	})
+}
+
+func TestUintFromPtr(t *testing.T) {
+	testUintFromPtr(t, UintFromPtr)
+	testUintFromPtr(t, Uint32FromPtr)
+	testUintFromPtr(t, Uint16FromPtr)
+	testUintFromPtr(t, Uint8FromPtr)
+}
+
+func testUintFromPtr[N nulluint, V internal.Unsigned](t *testing.T, fromPtr func(*V) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		n := V(123)
+		i := fromPtr(&n)
+		assertUint(t, i, "fromPtr()")
+
+		null := fromPtr(nil)
+		assertNullUint(t, null, "fromPtr(nil)")
+	})
+}
+
+func TestUnmarshalUint(t *testing.T) {
+	testUnmarshalUint[Uint](t)
+	testUnmarshalUint[Uint32](t)
+	testUnmarshalUint[Uint16](t)
+	testUnmarshalUint[Uint8](t)
+}
+
+func testUnmarshalUint[N nulluint](t *testing.T) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		var i N
+		err := json.Unmarshal(intJSON, &i)
+		maybePanic(err)
+		assertUint(t, i, "int json")
+
+		var si N
+		err = json.Unmarshal(intStringJSON, &si)
+		maybePanic(err)
+		assertUint(t, si, "int string json")
+
+		var zero N
+		err = json.Unmarshal(zeroJSON, &zero)
+		maybePanic(err)
+		assertNullUint(t, zero, "zero json")
+
+		var null N
+		err = json.Unmarshal(nullJSON, &null)
+		maybePanic(err)
+		assertNullUint(t, null, "null json")
+
+		var negative N
+		err = json.Unmarshal([]byte(`-1`), &negative)
+		if err == nil {
+			t.Error("err should be present; negative number decoded as unsigned")
+		}
+	})
+}
+
+func TestTextUnmarshalUint(t *testing.T) {
+	testTextUnmarshalUint(t, (*Uint).UnmarshalText)
+	testTextUnmarshalUint(t, (*Uint32).UnmarshalText)
+	testTextUnmarshalUint(t, (*Uint16).UnmarshalText)
+	testTextUnmarshalUint(t, (*Uint8).UnmarshalText)
+}
+
+func testTextUnmarshalUint[N nulluint](t *testing.T, unmarshal func(*N, []byte) error) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		var i N
+		err := unmarshal(&i, []byte("123"))
+		maybePanic(err)
+		assertUint(t, i, "unmarshal int")
+
+		var zero N
+		err = unmarshal(&zero, []byte("0"))
+		maybePanic(err)
+		assertNullUint(t, zero, "unmarshal zero int")
+
+		var blank N
+		err = unmarshal(&blank, []byte(""))
+		maybePanic(err)
+		assertNullUint(t, blank, "unmarshal empty int")
+
+		var invalid N
+		err = unmarshal(&invalid, []byte("-1"))
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestMarshalUint(t *testing.T) {
+	testMarshalUint(t, NewUint)
+	testMarshalUint(t, NewUint32)
+	testMarshalUint(t, NewUint16)
+	testMarshalUint(t, NewUint8)
+
+	data, err := json.Marshal(UintFrom(math.MaxUint64))
+	maybePanic(err)
+	assertJSONEquals(t, data, "18446744073709551615", "max uint64 json marshal")
+}
+
+func testMarshalUint[N interface {
+	MarshalJSON() ([]byte, error)
+	MarshalText() ([]byte, error)
+}, V internal.Unsigned](t *testing.T, newUint func(V, bool) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		i := newUint(123, true)
+		data, err := json.Marshal(i)
+		maybePanic(err)
+		assertJSONEquals(t, data, "123", "non-empty json marshal")
+		data, err = i.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, "123", "non-empty text marshal")
+
+		// invalid values should be encoded as 0
+		null := newUint(123, false)
+		data, err = json.Marshal(null)
+		maybePanic(err)
+		assertJSONEquals(t, data, "0", "null json marshal")
+		data, err = null.MarshalText()
+		maybePanic(err)
+		assertJSONEquals(t, data, "0", "null text marshal")
+	})
+}
+
+func TestUintIsZero(t *testing.T) {
+	testUintIsZero(t, NewUint)
+	testUintIsZero(t, NewUint32)
+	testUintIsZero(t, NewUint16)
+	testUintIsZero(t, NewUint8)
+}
+
+func testUintIsZero[N nulluint, V internal.Unsigned](t *testing.T, newUint func(V, bool) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		i := newUint(123, true)
+		if i.IsZero() {
+			t.Errorf("IsZero() should be false")
+		}
+
+		null := newUint(0, false)
+		if !null.IsZero() {
+			t.Errorf("IsZero() should be true")
+		}
+
+		zero := newUint(0, true)
+		if !zero.IsZero() {
+			t.Errorf("IsZero() should be true")
+		}
+	})
+}
+
+func TestUintScan(t *testing.T) {
+	testUintScan(t, (*Uint).Scan)
+	testUintScan(t, (*Uint32).Scan)
+	testUintScan(t, (*Uint16).Scan)
+	testUintScan(t, (*Uint8).Scan)
+}
+
+func testUintScan[N nulluint](t *testing.T, scan func(*N, any) error) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		for _, src := range []any{123, uint64(123), []byte("123"), "123"} {
+			var i N
+			err := scan(&i, src)
+			maybePanic(err)
+			assertUint(t, i, "scanned int")
+		}
+
+		var null N
+		err := scan(&null, nil)
+		maybePanic(err)
+		assertNullUint(t, null, "scanned null")
+
+		var negative N
+		err = scan(&negative, int64(-1))
+		if err == nil {
+			t.Error("expected error scanning negative value")
+		}
+	})
+}
+
+func TestUintValue(t *testing.T) {
+	v, err := UintFrom(123).Value()
+	maybePanic(err)
+	if v != int64(123) {
+		t.Errorf("bad value: %#v", v)
+	}
+
+	_, err = UintFrom(math.MaxInt64 + 1).Value()
+	if err == nil {
+		t.Error("expected error for value overflowing int64")
+	}
+
+	v, err = UintFrom(0).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad zero value: %#v", v)
+	}
+}
+
+func TestUintSetValid(t *testing.T) {
+	testUintSetValid(t, NewUint, (*Uint).SetValid)
+	testUintSetValid(t, NewUint32, (*Uint32).SetValid)
+	testUintSetValid(t, NewUint16, (*Uint16).SetValid)
+	testUintSetValid(t, NewUint8, (*Uint8).SetValid)
+}
+
+func testUintSetValid[N nulluint, V internal.Unsigned](t *testing.T, newUint func(V, bool) N, setValid func(*N, V)) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		change := newUint(0, false)
+		assertNullUint(t, change, "SetValid()")
+		setValid(&change, 123)
+		assertUint(t, change, "SetValid()")
+	})
+}
+
+func TestUintValueOr(t *testing.T) {
+	testUintValueOr(t, NewUint)
+	testUintValueOr(t, NewUint32)
+	testUintValueOr(t, NewUint16)
+	testUintValueOr(t, NewUint8)
+}
+
+func testUintValueOr[N interface {
+	ValueOr(V) V
+	ValueOrZero() V
+	Ptr() *V
+}, V internal.Unsigned](t *testing.T, newUint func(V, bool) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		valid := newUint(123, true)
+		if valid.ValueOr(V(100)) != 123 {
+			t.Error("unexpected ValueOr", valid.ValueOr(V(100)))
+		}
+		if *valid.Ptr() != 123 {
+			t.Error("unexpected Ptr", valid.Ptr())
+		}
+
+		invalid := newUint(123, false)
+		if invalid.ValueOr(V(100)) != V(100) {
+			t.Error("unexpected ValueOr", invalid.ValueOr(V(100)))
+		}
+		if invalid.ValueOrZero() != 0 {
+			t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+		}
+		if invalid.Ptr() != nil {
+			t.Error("unexpected Ptr", invalid.Ptr())
+		}
+	})
+}
+
+func TestUintEqual(t *testing.T) {
+	testUintEqual(t, NewUint)
+	testUintEqual(t, NewUint32)
+	testUintEqual(t, NewUint16)
+	testUintEqual(t, NewUint8)
+}
+
+func testUintEqual[N interface{ Equal(N) bool }, V internal.Unsigned](t *testing.T, newUint func(V, bool) N) {
+	t.Run(internal.TypeName[N](), func(t *testing.T) {
+		assertIntEqualIsTrue(t, newUint(10, false), newUint(20, false))
+		assertIntEqualIsTrue(t, newUint(10, true), newUint(10, true))
+		assertIntEqualIsTrue(t, newUint(0, true), newUint(10, false))
+		assertIntEqualIsFalse(t, newUint(10, true), newUint(10, false))
+		assertIntEqualIsFalse(t, newUint(10, true), newUint(20, true))
+	})
+}
+
+func assertUint(t *testing.T, i interface{ value() (uint64, bool) }, from string) {
+	t.Helper()
+	n, valid := i.value()
+	if n != 123 {
+		t.Errorf("bad %s int: %d ≠ %d\n", from, n, 123)
+	}
+	if !valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint(t *testing.T, i interface{ value() (uint64, bool) }, from string) {
+	t.Helper()
+	_, valid := i.value()
+	if valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
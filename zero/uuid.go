@@ -0,0 +1,201 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// UUID is a nullable UUID.
+// JSON marshals to the all-zero UUID, "00000000-0000-0000-0000-000000000000", if null.
+// The all-zero UUID produces a null UUID.
+// Null values and the all-zero UUID are considered equivalent.
+type UUID struct {
+	UUID  [16]byte
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// NewUUID creates a new UUID.
+func NewUUID(u [16]byte, valid bool) UUID {
+	return UUID{
+		UUID:  u,
+		Valid: valid,
+	}
+}
+
+// UUIDFrom creates a new UUID that will be null if u is all zeros.
+func UUIDFrom(u [16]byte) UUID {
+	return NewUUID(u, u != [16]byte{})
+}
+
+// UUIDFromPtr creates a new UUID that will be null if u is nil or all zeros.
+func UUIDFromPtr(u *[16]byte) UUID {
+	if u == nil {
+		return NewUUID([16]byte{}, false)
+	}
+	return UUIDFrom(*u)
+}
+
+// ParseUUID parses a UUID in the canonical form, such as "f81d4fae-7dec-11d0-a765-00a0c91e6bf6".
+// It also accepts braced and URN forms, and 32 hex digits without hyphens.
+// It returns a null UUID if s is blank or the all-zero UUID.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	err := u.UnmarshalText([]byte(s))
+	return u, err
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the all-zero UUID.
+func (u UUID) ValueOrZero() [16]byte {
+	if !u.Valid {
+		return [16]byte{}
+	}
+	return u.UUID
+}
+
+// ValueOr returns the inner value if valid, otherwise v.
+func (u UUID) ValueOr(v [16]byte) [16]byte {
+	if !u.Valid {
+		return v
+	}
+	return u.UUID
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts strings in the forms accepted by ParseUUID,
+// as well as raw 16-byte []byte values, such as from MySQL BINARY(16) columns.
+// The all-zero UUID produces a null UUID.
+func (u *UUID) Scan(src any) error {
+	if src == nil {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	v, err := internal.ScanUUID(src)
+	if err != nil {
+		u.UUID, u.Valid = [16]byte{}, false
+		return fmt.Errorf("zero: couldn't scan UUID: %w", err)
+	}
+	*u = UUIDFrom(v)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It sends the canonical string form. Use UUIDBytes to send raw bytes instead.
+func (u UUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return internal.UUIDString(u.UUID), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+// Blank strings and the all-zero UUID produce a null UUID.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "null", `""`:
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	v, err := internal.ParseUUID(str)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	*u = UUIDFrom(v)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UUID if the input is blank, "null", or the all-zero UUID.
+func (u *UUID) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		u.UUID, u.Valid = [16]byte{}, false
+		return nil
+	}
+	v, err := internal.ParseUUID(str)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	*u = UUIDFrom(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the all-zero UUID if this UUID is null.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return u.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this UUID to dst, as MarshalJSON does.
+// It will append the all-zero UUID if this UUID is null.
+func (u UUID) AppendJSON(dst []byte) ([]byte, error) {
+	dst = append(dst, '"')
+	dst = internal.AppendUUID(dst, u.ValueOrZero())
+	return append(dst, '"'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the all-zero UUID if this UUID is null.
+func (u UUID) MarshalText() ([]byte, error) {
+	return u.AppendText(nil)
+}
+
+// AppendText implements encoding.TextAppender.
+// It will append the all-zero UUID if this UUID is null.
+func (u UUID) AppendText(dst []byte) ([]byte, error) {
+	return internal.AppendUUID(dst, u.ValueOrZero()), nil
+}
+
+// SetValid changes this UUID's value and also sets it to be non-null.
+func (u *UUID) SetValid(v [16]byte) {
+	u.UUID = v
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this UUID's value, or a nil pointer if this UUID is null.
+func (u UUID) Ptr() *[16]byte {
+	if !u.Valid {
+		return nil
+	}
+	return &u.UUID
+}
+
+// IsZero returns true for null or all-zero UUIDs, for potential future omitempty support.
+func (u UUID) IsZero() bool {
+	return !u.Valid || u.UUID == [16]byte{}
+}
+
+// Equal returns true if both UUIDs have the same value or are both either null or all zeros.
+func (u UUID) Equal(other UUID) bool {
+	return u.ValueOrZero() == other.ValueOrZero()
+}
+
+// Compare returns an integer comparing two UUIDs byte-wise, following the conventions of cmp.Compare.
+// Null is considered equal to the all-zero UUID.
+func (u UUID) Compare(other UUID) int {
+	a, b := u.ValueOrZero(), other.ValueOrZero()
+	return bytes.Compare(a[:], b[:])
+}
+
+// UUIDBytes is a UUID that sends its raw 16 bytes to the database, for columns such as MySQL's BINARY(16).
+// It is otherwise the same as UUID, and can be made from one with UUIDBytes{u}.
+type UUIDBytes struct {
+	UUID
+}
+
+// Value implements the driver Valuer interface.
+// It sends the raw 16 bytes, or nil if null.
+func (u UUIDBytes) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.UUID[:], nil
+}
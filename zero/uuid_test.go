@@ -0,0 +1,62 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestUUID(t *testing.T) {
+	const nilUUID = "00000000-0000-0000-0000-000000000000"
+	value := [16]byte{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+
+	if UUIDFrom([16]byte{}).Valid {
+		t.Error("all-zero UUID should be null")
+	}
+	if u := UUIDFrom(value); !u.Valid || u.UUID != value {
+		t.Errorf("bad UUIDFrom(): %#v", u)
+	}
+
+	for _, src := range []any{nilUUID, make([]byte, 16)} {
+		var u UUID
+		err := u.Scan(src)
+		maybePanic(err)
+		if u.Valid {
+			t.Errorf("scanning %#v should be null", src)
+		}
+	}
+
+	for _, data := range []string{`null`, `""`, `"` + nilUUID + `"`} {
+		var u UUID
+		err := json.Unmarshal([]byte(data), &u)
+		maybePanic(err)
+		if u.Valid {
+			t.Errorf("%s should unmarshal to null", data)
+		}
+	}
+
+	data, err := json.Marshal(UUID{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+nilUUID+`"`, "null json marshal")
+	data, err = UUIDFrom(value).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", "text marshal")
+
+	parsed, err := ParseUUID(nilUUID)
+	maybePanic(err)
+	if !parsed.IsZero() || !parsed.Equal(UUID{}) {
+		t.Error("parsed all-zero UUID should equal null")
+	}
+	if c := (UUID{}).Compare(UUIDFrom(value)); c != -1 {
+		t.Errorf("null vs value: %d", c)
+	}
+
+	v, err := UUIDBytes{UUIDFrom(value)}.Value()
+	maybePanic(err)
+	if b, ok := v.([]byte); !ok || !bytes.Equal(b, value[:]) {
+		t.Errorf("bad UUIDBytes Value(): %#v", v)
+	}
+	if v, err := (UUIDBytes{}).Value(); err != nil || v != nil {
+		t.Errorf("null UUIDBytes Value() should be nil, got %#v", v)
+	}
+}
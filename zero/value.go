@@ -3,9 +3,13 @@
 package zero
 
 import (
+	"cmp"
 	"database/sql"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+
+	"github.com/guregu/null/v6/internal"
 )
 
 type Value[T comparable] struct {
@@ -57,11 +61,18 @@ func (t Value[T]) ValueOr(v T) T {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this value is null or zero.
 func (t Value[T]) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this Value to dst, as MarshalJSON does.
+// It will append null if this value is null or zero.
+func (t Value[T]) AppendJSON(dst []byte) ([]byte, error) {
 	var zero T
 	if !t.Valid || t.V == zero {
-		return []byte("null"), nil
+		return append(dst, "null"...), nil
 	}
-	return json.Marshal(t.V)
+	data, err := json.Marshal(t.V)
+	return append(dst, data...), err
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -82,6 +93,92 @@ func (t *Value[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value of T if this Value is null.
+func (t Value[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(t.ValueOrZero(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// Zero values and elements with an xsi:nil="true" attribute decode to null.
+func (t *Value[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var zero T
+	t.V = zero
+	if internal.IsXMLNil(start) {
+		t.Valid = false
+		return d.Skip()
+	}
+	if err := d.DecodeElement(&t.V, &start); err != nil {
+		t.Valid = false
+		return fmt.Errorf("zero: couldn't unmarshal XML: %w", err)
+	}
+	t.Valid = t.V != zero
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value of T if this Value is null.
+func (t Value[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	v := t.ValueOrZero()
+	text, ok, err := internal.XMLAttrText(&v, name)
+	if err != nil || !ok {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// Zero values decode to null.
+func (t *Value[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var zero T
+	t.V = zero
+	if err := internal.UnmarshalXMLAttrText(&t.V, attr); err != nil {
+		t.Valid = false
+		return fmt.Errorf("zero: couldn't unmarshal XML attribute: %w", err)
+	}
+	t.Valid = t.V != zero
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// It will encode only the header byte if this Value is null or zero,
+// otherwise T using its MarshalBinary method if it has one, or as JSON.
+func (t Value[T]) MarshalBinary() ([]byte, error) {
+	valid := !t.IsZero()
+	b := internal.AppendBinaryHeader(nil, valid)
+	if !valid {
+		return b, nil
+	}
+	return internal.AppendBinaryValue(b, t.V)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It decodes T using its UnmarshalBinary method if it has one, or as JSON.
+// Zero values decode to null.
+func (t *Value[T]) UnmarshalBinary(data []byte) error {
+	var zero T
+	t.V, t.Valid = zero, false
+	payload, valid, err := internal.ReadBinary(data)
+	if err == nil && valid {
+		err = internal.ParseBinaryValue(payload, &t.V)
+	}
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal binary: %w", err)
+	}
+	t.Valid = t.V != zero
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. It uses the same format as MarshalBinary.
+func (t Value[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It uses the same format as UnmarshalBinary.
+func (t *Value[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
 // SetValid changes this Value's value and sets it to be non-null.
 func (t *Value[T]) SetValid(v T) {
 	t.V = v
@@ -106,3 +203,15 @@ func (t Value[T]) IsZero() bool {
 func (t Value[T]) Equal(other Value[T]) bool {
 	return t.ValueOrZero() == other.ValueOrZero()
 }
+
+// CompareFunc returns an integer comparing two Values with cmp, following the conventions of cmp.Compare.
+// Null is considered equal to the zero value. For ordered types, CompareValue is shorter.
+func (t Value[T]) CompareFunc(other Value[T], cmp func(a, b T) int) int {
+	return cmp(t.ValueOrZero(), other.ValueOrZero())
+}
+
+// CompareValue returns an integer comparing two Values of an ordered type, following the conventions of cmp.Compare.
+// Null is considered equal to the zero value.
+func CompareValue[T cmp.Ordered](a, b Value[T]) int {
+	return a.CompareFunc(b, cmp.Compare[T])
+}
@@ -205,3 +205,23 @@ func testValueNull[T comparable](t *testing.T, value T, good T) {
 		}
 	})
 }
+
+func TestValueCompare(t *testing.T) {
+	table := []struct {
+		a, b Value[int]
+		want int
+	}{
+		{ValueFrom(1), ValueFrom(2), -1},
+		{ValueFrom(2), ValueFrom(1), 1},
+		{Value[int]{}, ValueFrom(0), 0},
+		{Value[int]{}, ValueFrom(-1), 1},
+	}
+	for _, test := range table {
+		if got := CompareValue(test.a, test.b); got != test.want {
+			t.Errorf("CompareValue(%v, %v): %d ≠ %d", test.a, test.b, got, test.want)
+		}
+		if got := test.a.CompareFunc(test.b, func(a, b int) int { return b - a }); got != -test.want {
+			t.Errorf("%v.CompareFunc(%v) reversed: %d ≠ %d", test.a, test.b, got, -test.want)
+		}
+	}
+}
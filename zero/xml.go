@@ -0,0 +1,575 @@
+package zero
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/guregu/null/v6/internal"
+)
+
+// The types in this package encode XML elements and attributes using their text form,
+// as given by MarshalText, so null values are written as their zero value.
+// They decode with UnmarshalText. Elements with an xsi:nil="true" attribute decode to null.
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this String is null.
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, s)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (s *String) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this String is null.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (s *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return s.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Int is null.
+func (i Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Int is null.
+func (i Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Int32 is null.
+func (i Int32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Int32 is null.
+func (i Int32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Int16 is null.
+func (i Int16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int16) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Int16 is null.
+func (i Int16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Int8 is null.
+func (i Int8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Int8) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Int8 is null.
+func (i Int8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Byte is null.
+func (b Byte) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *Byte) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Byte is null.
+func (b Byte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Byte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Uint is null.
+func (i Uint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Uint is null.
+func (i Uint) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Uint32 is null.
+func (i Uint32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Uint32 is null.
+func (i Uint32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Uint16 is null.
+func (i Uint16) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint16) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Uint16 is null.
+func (i Uint16) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint16) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Uint8 is null.
+func (i Uint8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, i)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (i *Uint8) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Uint8 is null.
+func (i Uint8) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint8) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Float is null.
+func (f Float) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, f)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (f *Float) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Float is null.
+func (f Float) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *Float) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Float32 is null.
+func (f Float32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, f)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (f *Float32) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Float32 is null.
+func (f Float32) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *Float32) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this BigInt is null.
+func (b BigInt) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *BigInt) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this BigInt is null.
+func (b BigInt) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *BigInt) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Bool is null.
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *Bool) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Bool is null.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Time is null.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, t)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (t *Time) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Time is null.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Date is null.
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Date is null.
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Duration is null.
+func (d Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, d)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (d *Duration) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, d)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Duration is null.
+func (d Duration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, d)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (d *Duration) UnmarshalXMLAttr(attr xml.Attr) error {
+	return d.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this JSON is null.
+func (j JSON) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, j)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (j *JSON) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, j)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this JSON is null.
+func (j JSON) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, j)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (j *JSON) UnmarshalXMLAttr(attr xml.Attr) error {
+	return j.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Bytes is null.
+func (b Bytes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *Bytes) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Bytes is null.
+func (b Bytes) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Bytes) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this BytesHex is null.
+func (b BytesHex) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, b)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (b *BytesHex) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this BytesHex is null.
+func (b BytesHex) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *BytesHex) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this UUID is null.
+func (u UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, u)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (u *UUID) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, u)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this UUID is null.
+func (u UUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (u *UUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Addr is null.
+func (a Addr) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, a)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (a *Addr) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, a)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Addr is null.
+func (a Addr) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, a)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (a *Addr) UnmarshalXMLAttr(attr xml.Attr) error {
+	return a.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this Prefix is null.
+func (p Prefix) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, p)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (p *Prefix) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, p)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this Prefix is null.
+func (p Prefix) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, p)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (p *Prefix) UnmarshalXMLAttr(attr xml.Attr) error {
+	return p.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode the zero value if this AddrPort is null.
+func (ap AddrPort) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, ap)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (ap *AddrPort) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(dec, start, ap)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// It will encode the zero value if this AddrPort is null.
+func (ap AddrPort) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, ap)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (ap *AddrPort) UnmarshalXMLAttr(attr xml.Attr) error {
+	return ap.UnmarshalText([]byte(attr.Value))
+}
+
+func marshalXML(e *xml.Encoder, start xml.StartElement, v encoding.TextMarshaler) error {
+	text, err := v.MarshalText()
+	if err != nil {
+		return err
+	}
+	return internal.MarshalXMLText(e, start, text)
+}
+
+func marshalXMLAttr(name xml.Name, v encoding.TextMarshaler) (xml.Attr, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// unmarshalXML decodes an element's text with v's UnmarshalText method.
+// Elements marked with xsi:nil set v to its zero value, which is null.
+func unmarshalXML[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](d *xml.Decoder, start xml.StartElement, v PT) error {
+	text, err := internal.DecodeXMLText(d, start)
+	if err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal XML: %w", err)
+	}
+	if text == nil {
+		var zero T
+		*v = zero
+		return nil
+	}
+	return v.UnmarshalText(text)
+}
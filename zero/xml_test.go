@@ -0,0 +1,77 @@
+package zero
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+// xmlTypes is every type in this package, which should all support XML.
+var xmlTypes = []any{
+	String{}, Int{}, Int32{}, Int16{}, Int8{}, Byte{}, Uint{}, Uint32{}, Uint16{}, Uint8{},
+	Float{}, Float32{}, BigInt{}, Bool{}, Time{}, Date{}, Duration{},
+	JSON{}, Bytes{}, UUID{}, Addr{}, Prefix{}, AddrPort{}, Value[int]{},
+}
+
+func TestXMLInterfaces(t *testing.T) {
+	for _, v := range xmlTypes {
+		if _, ok := v.(xml.Marshaler); !ok {
+			t.Errorf("%T doesn't implement xml.Marshaler", v)
+		}
+		if _, ok := v.(xml.MarshalerAttr); !ok {
+			t.Errorf("%T doesn't implement xml.MarshalerAttr", v)
+		}
+	}
+}
+
+type xmlRecord struct {
+	XMLName xml.Name   `xml:"record"`
+	ID      Int        `xml:"id,attr"`
+	Name    String     `xml:"name"`
+	Score   Float      `xml:"score"`
+	Active  Bool       `xml:"active"`
+	Born    Date       `xml:"born"`
+	Count   Value[int] `xml:"count"`
+}
+
+func TestXMLNull(t *testing.T) {
+	data, err := xml.Marshal(xmlRecord{})
+	maybePanic(err)
+	want := `<record id="0"><name></name><score>0</score><active>false</active><born>0001-01-01</born><count>0</count></record>`
+	assertJSONEquals(t, data, want, "null xml marshal")
+
+	out := xmlRecord{ID: IntFrom(1), Name: StringFrom("x"), Score: FloatFrom(1), Active: BoolFrom(true), Count: ValueFrom(1)}
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.ID.Valid || out.Name.Valid || out.Score.Valid || out.Active.Valid || out.Born.Valid || out.Count.Valid {
+		t.Errorf("zero values should unmarshal as null: %+v", out)
+	}
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	in := xmlRecord{ID: IntFrom(1), Name: StringFrom("<test>"), Score: FloatFrom(1.5), Active: BoolFrom(true), Count: ValueFrom(3)}
+	data, err := xml.Marshal(in)
+	maybePanic(err)
+	want := `<record id="1"><name>&lt;test&gt;</name><score>1.5</score><active>true</active><born>0001-01-01</born><count>3</count></record>`
+	assertJSONEquals(t, data, want, "xml marshal")
+
+	var out xmlRecord
+	err = xml.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.ID.Equal(in.ID) || !out.Name.Equal(in.Name) || !out.Score.Equal(in.Score) ||
+		!out.Active.Equal(in.Active) || !out.Born.Equal(in.Born) || !out.Count.Equal(in.Count) {
+		t.Errorf("bad round trip: %+v ≠ %+v", out, in)
+	}
+}
+
+func TestXMLNil(t *testing.T) {
+	out := xmlRecord{Name: StringFrom("x"), Count: ValueFrom(1)}
+	err := xml.Unmarshal([]byte(`<record><name xsi:nil="true"/><count xsi:nil="true"/></record>`), &out)
+	maybePanic(err)
+	if out.Name.Valid || out.Count.Valid {
+		t.Errorf("xsi:nil elements should be null: %+v", out)
+	}
+
+	if err := xml.Unmarshal([]byte(`<record id="x"></record>`), &out); err == nil {
+		t.Error("expected error: bad attribute")
+	}
+}